@@ -9,3 +9,12 @@ output "client_name" {
 output "client_ipv4" {
   value = data.wgeasy_client.example.ipv4_address
 }
+
+# Look a client up by its name or public key instead
+data "wgeasy_client" "by_name" {
+  name = "my-laptop"
+}
+
+data "wgeasy_client" "by_public_key" {
+  public_key = "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+}
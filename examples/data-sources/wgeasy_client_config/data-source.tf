@@ -0,0 +1,8 @@
+data "wgeasy_client_config" "example" {
+  client_id = "1"
+}
+
+resource "local_sensitive_file" "example" {
+  filename = "${path.module}/example.conf"
+  content  = data.wgeasy_client_config.example.configuration
+}
@@ -0,0 +1,9 @@
+data "wgeasy_client_qrcode" "example" {
+  client_id = "1"
+  png_size  = 512
+}
+
+resource "local_sensitive_file" "example_svg" {
+  filename = "${path.module}/example.svg"
+  content  = data.wgeasy_client_qrcode.example.svg
+}
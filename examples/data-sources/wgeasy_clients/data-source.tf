@@ -3,3 +3,11 @@ data "wgeasy_clients" "all" {}
 output "all_clients" {
   value = data.wgeasy_clients.all.clients
 }
+
+# Enabled laptops in the first /28, sorted by address
+data "wgeasy_clients" "laptops" {
+  name_regex = "^laptop-"
+  enabled    = true
+  ipv4_cidr  = "10.8.0.0/28"
+  sort_by    = "ipv4_address"
+}
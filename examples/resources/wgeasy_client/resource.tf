@@ -12,3 +12,8 @@ resource "wgeasy_client" "custom" {
   persistent_keepalive = 25
   enabled            = true
 }
+
+resource "wgeasy_client" "router" {
+  name       = "edge-router"
+  public_key = "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+}
@@ -0,0 +1,12 @@
+resource "wgeasy_client" "laptop" {
+  name = "my-laptop"
+}
+
+resource "wgeasy_client_one_time_link" "laptop" {
+  client_id = wgeasy_client.laptop.id
+}
+
+output "laptop_download_url" {
+  value     = wgeasy_client_one_time_link.laptop.url
+  sensitive = true
+}
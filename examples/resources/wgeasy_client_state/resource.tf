@@ -0,0 +1,4 @@
+resource "wgeasy_client_state" "lost_laptop" {
+  client_id = "42"
+  enabled   = false
+}
@@ -0,0 +1,6 @@
+resource "wgeasy_interface" "wg0" {
+  port      = 51820
+  device    = "eth0"
+  mtu       = 1420
+  ipv4_cidr = "10.8.0.0/24"
+}
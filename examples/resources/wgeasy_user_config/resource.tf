@@ -0,0 +1,8 @@
+resource "wgeasy_user_config" "defaults" {
+  host                         = "vpn.example.com"
+  port                         = 51820
+  default_dns                  = ["1.1.1.1", "1.0.0.1"]
+  default_allowed_ips          = ["0.0.0.0/0", "::/0"]
+  default_mtu                  = 1420
+  default_persistent_keepalive = 25
+}
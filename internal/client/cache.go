@@ -0,0 +1,90 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// clientListCache coalesces concurrent GET /api/client calls and keeps the
+// result for a short TTL. Any write bumps the generation, which both drops
+// the cached list and keeps fetches started before the write from storing
+// their now-stale result.
+type clientListCache struct {
+	ttl   time.Duration
+	group singleflight.Group
+
+	mu         sync.Mutex
+	generation uint64
+	clients    []Client
+	fetchedAt  time.Time
+}
+
+// WithClientListCache makes GetClients share a single in-flight request among
+// concurrent callers and reuse its result for ttl, and makes GetClient look
+// clients up in that list. The cache is dropped on every write. Zero disables it.
+func WithClientListCache(ttl time.Duration) Option {
+	return func(c *WGEasyClient) {
+		if ttl > 0 {
+			c.listCache = &clientListCache{ttl: ttl}
+		}
+	}
+}
+
+// get returns the cached list if it is fresh, or joins (or starts) a fetch.
+// The returned slice is a copy, but the clients share their nested slices
+// with the cache and must not be modified.
+func (lc *clientListCache) get(ctx context.Context, fetch func(context.Context) ([]Client, error)) ([]Client, error) {
+	lc.mu.Lock()
+	generation := lc.generation
+	if lc.clients != nil && time.Since(lc.fetchedAt) < lc.ttl {
+		clients := slices.Clone(lc.clients)
+		lc.mu.Unlock()
+		return clients, nil
+	}
+	lc.mu.Unlock()
+
+	// The fetch is shared, so it must not die with whichever caller happened
+	// to start it; each caller gives up on its own context below instead.
+	// The HTTP client's request timeout and retry policy still bound it.
+	fetchCtx := context.WithoutCancel(ctx)
+	ch := lc.group.DoChan(strconv.FormatUint(generation, 10), func() (interface{}, error) {
+		clients, err := fetch(fetchCtx)
+		if err != nil {
+			return nil, err
+		}
+		lc.mu.Lock()
+		if lc.generation == generation {
+			lc.clients = clients
+			lc.fetchedAt = time.Now()
+		}
+		lc.mu.Unlock()
+		return clients, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return slices.Clone(res.Val.([]Client)), nil
+	}
+}
+
+// invalidate drops the cached list. It is safe to call on a nil cache.
+func (lc *clientListCache) invalidate() {
+	if lc == nil {
+		return
+	}
+	lc.mu.Lock()
+	lc.generation++
+	lc.clients = nil
+	lc.mu.Unlock()
+}
@@ -0,0 +1,170 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func setupCachedTestServer(t *testing.T, ttl time.Duration, lists *atomic.Int32, delay time.Duration) *WGEasyClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client" && r.Method == http.MethodGet {
+			lists.Add(1)
+			time.Sleep(delay)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode([]Client{{ID: "abc-123", Name: "client-1"}, {ID: "def-456", Name: "client-2"}})
+			return
+		}
+		if r.URL.Path == "/api/client/abc-123" && r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.Method == http.MethodGet {
+			t.Errorf("unexpected per-client request %s with the list cache enabled", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewWGEasyClient(server.URL, "admin", "secret", WithClientListCache(ttl))
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	return client
+}
+
+func TestClientListCacheCoalescesConcurrentCalls(t *testing.T) {
+	var lists atomic.Int32
+	client := setupCachedTestServer(t, time.Minute, &lists, 50*time.Millisecond)
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetClient(context.Background(), "def-456"); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if lists.Load() != 1 {
+		t.Errorf("expected 1 list request, got %d", lists.Load())
+	}
+}
+
+func TestClientListCacheInvalidatedOnWrite(t *testing.T) {
+	var lists atomic.Int32
+	client := setupCachedTestServer(t, time.Minute, &lists, 0)
+	ctx := context.Background()
+
+	for range 3 {
+		if _, err := client.GetClients(ctx); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if lists.Load() != 1 {
+		t.Fatalf("expected cached list to be reused, got %d requests", lists.Load())
+	}
+
+	if err := client.DeleteClient(ctx, "abc-123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GetClients(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lists.Load() != 2 {
+		t.Errorf("expected a fresh list after a write, got %d requests", lists.Load())
+	}
+}
+
+func TestClientListCacheExpires(t *testing.T) {
+	var lists atomic.Int32
+	client := setupCachedTestServer(t, 10*time.Millisecond, &lists, 0)
+	ctx := context.Background()
+
+	if _, err := client.GetClients(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if _, err := client.GetClients(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lists.Load() != 2 {
+		t.Errorf("expected the list to be refetched after the TTL, got %d requests", lists.Load())
+	}
+}
+
+func TestClientListCacheNotFound(t *testing.T) {
+	var lists atomic.Int32
+	client := setupCachedTestServer(t, time.Minute, &lists, 0)
+
+	_, err := client.GetClient(context.Background(), "nonexistent")
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Fatalf("expected NotFoundError, got: %T", err)
+	}
+}
+
+func TestClientListCacheLeaderCancellation(t *testing.T) {
+	lc := &clientListCache{ttl: time.Minute}
+	var fetches atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) ([]Client, error) {
+		if fetches.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return []Client{{ID: "abc-123"}}, nil
+	}
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := lc.get(leaderCtx, fetch)
+		leaderErr <- err
+	}()
+	<-started
+
+	followerDone := make(chan error, 1)
+	go func() {
+		clients, err := lc.get(context.Background(), fetch)
+		if err == nil && len(clients) != 1 {
+			err = fmt.Errorf("expected 1 client, got %d", len(clients))
+		}
+		followerDone <- err
+	}()
+	// Give the follower time to join the in-flight fetch.
+	time.Sleep(20 * time.Millisecond)
+
+	cancel()
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the leader to see its own cancellation, got %v", err)
+	}
+	close(release)
+
+	if err := <-followerDone; err != nil {
+		t.Errorf("follower failed: %v", err)
+	}
+	if fetches.Load() != 1 {
+		t.Errorf("expected 1 shared fetch, got %d", fetches.Load())
+	}
+}
@@ -2,10 +2,14 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func setupTestServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *WGEasyClient) {
@@ -36,7 +40,7 @@ func TestLogin(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	err := client.login()
+	err := client.login(context.Background())
 	if err != nil {
 		t.Fatalf("expected successful login, got: %v", err)
 	}
@@ -48,7 +52,7 @@ func TestLoginFailure(t *testing.T) {
 		w.Write([]byte("invalid credentials"))
 	})
 
-	err := client.login()
+	err := client.login(context.Background())
 	if err == nil {
 		t.Fatal("expected error on failed login")
 	}
@@ -86,7 +90,7 @@ func TestGetClients(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	clients, err := client.GetClients()
+	clients, err := client.GetClients(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -99,6 +103,34 @@ func TestGetClients(t *testing.T) {
 }
 
 func TestGetClient(t *testing.T) {
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client" {
+			t.Error("GetClient should not list all clients")
+		}
+		if r.URL.Path == "/api/client/def-456" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(Client{ID: "def-456", Name: "client-2", IPv4Address: "10.8.0.3"})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	c, err := client.GetClient(context.Background(), "def-456")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Name != "client-2" {
+		t.Errorf("expected 'client-2', got '%s'", c.Name)
+	}
+}
+
+func TestGetClientFallsBackToList(t *testing.T) {
+	var singleGets int
 	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == "/api/session" {
 			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
@@ -114,15 +146,99 @@ func TestGetClient(t *testing.T) {
 			json.NewEncoder(w).Encode(clients)
 			return
 		}
+		if r.Method == http.MethodGet {
+			// Older servers only expose the list endpoint.
+			singleGets++
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	c, err := client.GetClient("def-456")
-	if err != nil {
+	for range 2 {
+		c, err := client.GetClient(context.Background(), "def-456")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if c.Name != "client-2" {
+			t.Errorf("expected 'client-2', got '%s'", c.Name)
+		}
+	}
+	if singleGets != 1 {
+		t.Errorf("expected the per-client endpoint to be probed once, got %d", singleGets)
+	}
+
+	_, err := client.GetClient(context.Background(), "nonexistent")
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Fatalf("expected NotFoundError, got: %T", err)
+	}
+}
+
+func TestGetClientUnknownRouteFallsBackToList(t *testing.T) {
+	var singleGets int
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode([]Client{{ID: "def-456", Name: "client-2"}})
+			return
+		}
+		if r.Method == http.MethodGet {
+			// Builds without the per-client route answer unknown routes with 404.
+			singleGets++
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	for range 2 {
+		c, err := client.GetClient(context.Background(), "def-456")
+		if err != nil {
+			t.Fatalf("a 404 from the per-client route must not hide a listed client: %v", err)
+		}
+		if c.Name != "client-2" {
+			t.Errorf("expected 'client-2', got '%s'", c.Name)
+		}
+	}
+	if singleGets != 1 {
+		t.Errorf("expected the per-client endpoint to be probed once, got %d", singleGets)
+	}
+}
+
+func TestGetClientNotFoundTrustedOnceRouteConfirmed(t *testing.T) {
+	var lists int
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client" && r.Method == http.MethodGet {
+			lists++
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode([]Client{{ID: "abc-123", Name: "client-1"}})
+			return
+		}
+		if r.URL.Path == "/api/client/abc-123" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(Client{ID: "abc-123", Name: "client-1"})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, err := client.GetClient(context.Background(), "abc-123"); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if c.Name != "client-2" {
-		t.Errorf("expected 'client-2', got '%s'", c.Name)
+	_, err := client.GetClient(context.Background(), "nonexistent")
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Fatalf("expected NotFoundError, got: %T", err)
+	}
+	if lists != 0 {
+		t.Errorf("expected no list requests once the per-client route is confirmed, got %d", lists)
 	}
 }
 
@@ -135,19 +251,22 @@ func TestGetClientNotFound(t *testing.T) {
 		}
 		if r.URL.Path == "/api/client" && r.Method == http.MethodGet {
 			w.Header().Set("Content-Type", "application/json")
-			json.NewEncoder(w).Encode([]Client{})
+			json.NewEncoder(w).Encode([]Client{{ID: "abc-123", Name: "client-1"}})
 			return
 		}
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	_, err := client.GetClient("nonexistent")
+	_, err := client.GetClient(context.Background(), "nonexistent")
 	if err == nil {
 		t.Fatal("expected NotFoundError")
 	}
 	if _, ok := err.(*NotFoundError); !ok {
 		t.Fatalf("expected NotFoundError, got: %T", err)
 	}
+	if strings.Contains(err.Error(), "abc-123") {
+		t.Errorf("error should not list other client IDs: %v", err)
+	}
 }
 
 func TestCreateClient(t *testing.T) {
@@ -171,7 +290,7 @@ func TestCreateClient(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	clientID, err := client.CreateClient(CreateClientRequest{Name: "new-client"})
+	clientID, err := client.CreateClient(context.Background(), CreateClientRequest{Name: "new-client"})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -196,18 +315,16 @@ func TestUpdateClient(t *testing.T) {
 			w.WriteHeader(http.StatusOK)
 			return
 		}
-		if r.URL.Path == "/api/client" && r.Method == http.MethodGet {
-			clients := []Client{
-				{
-					ID:          "abc-123",
-					Name:        "updated-name",
-					Enabled:     true,
-					IPv4Address: "10.8.0.2",
-					MTU:         1420,
-				},
+		if r.URL.Path == "/api/client/abc-123" && r.Method == http.MethodGet {
+			c := Client{
+				ID:          "abc-123",
+				Name:        "updated-name",
+				Enabled:     true,
+				IPv4Address: "10.8.0.2",
+				MTU:         1420,
 			}
 			w.Header().Set("Content-Type", "application/json")
-			json.NewEncoder(w).Encode(clients)
+			json.NewEncoder(w).Encode(c)
 			return
 		}
 		w.WriteHeader(http.StatusNotFound)
@@ -218,7 +335,7 @@ func TestUpdateClient(t *testing.T) {
 		Enabled: true,
 		MTU:     1420,
 	}
-	updated, err := client.UpdateClient("abc-123", updateReq)
+	updated, err := client.UpdateClient(context.Background(), "abc-123", updateReq)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -244,7 +361,7 @@ func TestDeleteClient(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	err := client.DeleteClient("abc-123")
+	err := client.DeleteClient(context.Background(), "abc-123")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -264,7 +381,7 @@ func TestDeleteClientNotFound(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	err := client.DeleteClient("nonexistent")
+	err := client.DeleteClient(context.Background(), "nonexistent")
 	if err != nil {
 		t.Fatalf("expected idempotent delete, got: %v", err)
 	}
@@ -293,7 +410,7 @@ func TestAutoReloginOn401(t *testing.T) {
 		w.WriteHeader(http.StatusNotFound)
 	})
 
-	clients, err := client.GetClients()
+	clients, err := client.GetClients(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -307,3 +424,252 @@ func TestAutoReloginOn401(t *testing.T) {
 		t.Errorf("expected 2 API calls (1 failed + 1 retry), got %d", callCount)
 	}
 }
+
+func TestGetClientConfiguration(t *testing.T) {
+	const config = "[Interface]\nPrivateKey = abc\nAddress = 10.8.0.2/24\n"
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client/abc-123/configuration" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte(config))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	got, err := client.GetClientConfiguration(context.Background(), "abc-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != config {
+		t.Errorf("expected %q, got %q", config, got)
+	}
+
+	_, err = client.GetClientConfiguration(context.Background(), "nonexistent")
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Fatalf("expected NotFoundError, got: %T", err)
+	}
+}
+
+func TestGetClientQRCode(t *testing.T) {
+	const svg = `<svg xmlns="http://www.w3.org/2000/svg"></svg>`
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client/abc-123/qrcode.svg" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "image/svg+xml")
+			w.Write([]byte(svg))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	got, err := client.GetClientQRCode(context.Background(), "abc-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != svg {
+		t.Errorf("expected %q, got %q", svg, got)
+	}
+}
+
+func TestGenerateOneTimeLink(t *testing.T) {
+	generated := false
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client/abc-123/generateOneTimeLink" && r.Method == http.MethodPost {
+			generated = true
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client/abc-123" && r.Method == http.MethodGet {
+			c := Client{ID: "abc-123", Name: "client-1"}
+			if generated {
+				c.OneTimeLink = &OneTimeLink{OneTimeLink: "tok3n", ExpiresAt: "2030-01-01T00:05:00.000Z"}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(c)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	link, err := client.GenerateOneTimeLink(context.Background(), "abc-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if link.OneTimeLink != "tok3n" || link.ExpiresAt != "2030-01-01T00:05:00.000Z" {
+		t.Errorf("unexpected one-time link: %+v", link)
+	}
+	if url := client.OneTimeLinkURL(link.OneTimeLink); url != client.endpoint+"/cnf/tok3n" {
+		t.Errorf("unexpected one-time link URL: %s", url)
+	}
+}
+
+func TestOneTimeLinkUnmarshalString(t *testing.T) {
+	var c Client
+	if err := json.Unmarshal([]byte(`{"id":1,"oneTimeLink":"tok3n"}`), &c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.OneTimeLink == nil || c.OneTimeLink.OneTimeLink != "tok3n" {
+		t.Errorf("unexpected one-time link: %+v", c.OneTimeLink)
+	}
+}
+
+func TestUpdateClientKeys(t *testing.T) {
+	var bodies []map[string]interface{}
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client/abc-123" && r.Method == http.MethodPost {
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			bodies = append(bodies, body)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client/abc-123" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(Client{ID: "abc-123", Name: "client-1"})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, err := client.UpdateClient(context.Background(), "abc-123", UpdateClientRequest{Name: "client-1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	publicKey := "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+	if _, err := client.UpdateClient(context.Background(), "abc-123", UpdateClientRequest{Name: "client-1", PublicKey: &publicKey}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := bodies[0]["publicKey"]; ok {
+		t.Errorf("expected publicKey to be omitted, got %v", bodies[0]["publicKey"])
+	}
+	if _, ok := bodies[0]["preSharedKey"]; ok {
+		t.Errorf("expected preSharedKey to be omitted, got %v", bodies[0]["preSharedKey"])
+	}
+	if bodies[1]["publicKey"] != publicKey {
+		t.Errorf("expected publicKey %q, got %v", publicKey, bodies[1]["publicKey"])
+	}
+}
+
+func TestEnableDisableClient(t *testing.T) {
+	var calls []string
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if (r.URL.Path == "/api/client/abc-123/enable" || r.URL.Path == "/api/client/abc-123/disable") && r.Method == http.MethodPost {
+			calls = append(calls, r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if err := client.DisableClient(context.Background(), "abc-123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.EnableClient(context.Background(), "abc-123"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(calls) != 2 || calls[0] != "/api/client/abc-123/disable" || calls[1] != "/api/client/abc-123/enable" {
+		t.Errorf("unexpected calls: %v", calls)
+	}
+
+	err := client.EnableClient(context.Background(), "nonexistent")
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Fatalf("expected NotFoundError, got: %T", err)
+	}
+}
+
+func TestContextCancellation(t *testing.T) {
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		// Simulate a hung server: never answer until the client gives up.
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetClients(ctx)
+	if err == nil {
+		t.Fatal("expected error on cancelled context")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got: %v", err)
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		<-r.Context().Done()
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewWGEasyClient(server.URL, "admin", "secret", WithRequestTimeout(50*time.Millisecond))
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	start := time.Now()
+	if _, err := client.GetClients(context.Background()); err == nil {
+		t.Fatal("expected timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("request was not bounded by the timeout, took %s", elapsed)
+	}
+}
+
+func TestCustomHeaders(t *testing.T) {
+	var checked int
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("CF-Access-Client-Id"); got != "id.access" {
+			t.Errorf("%s %s: expected CF-Access-Client-Id header, got %q", r.Method, r.URL.Path, got)
+		}
+		if r.Host != "wg.example.com" {
+			t.Errorf("%s %s: expected Host wg.example.com, got %q", r.Method, r.URL.Path, r.Host)
+		}
+		checked++
+		emptyListHandler(w, r)
+	})
+	WithHeaders(map[string]string{
+		"CF-Access-Client-Id": "id.access",
+		"Host":                "wg.example.com",
+	})(client)
+
+	if _, err := client.GetClients(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if checked != 2 {
+		t.Errorf("expected login and list requests, got %d", checked)
+	}
+}
@@ -5,12 +5,11 @@ import "fmt"
 
 // NotFoundError is returned when a client/peer is not found.
 type NotFoundError struct {
-	ID       string
-	FoundIDs []string
+	ID string
 }
 
 func (e *NotFoundError) Error() string {
-	return fmt.Sprintf("client with ID %s not found (available IDs: %v)", e.ID, e.FoundIDs)
+	return fmt.Sprintf("client with ID %s not found", e.ID)
 }
 
 // AuthenticationError is returned when authentication fails.
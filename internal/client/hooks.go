@@ -0,0 +1,48 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// GetHooks returns the interface-level hook scripts.
+func (c *WGEasyClient) GetHooks(ctx context.Context) (*Hooks, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/api/admin/hooks", nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching hooks: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status %d fetching hooks: %s", resp.StatusCode, string(respBody))
+	}
+
+	var hooks Hooks
+	if err := json.NewDecoder(resp.Body).Decode(&hooks); err != nil {
+		return nil, fmt.Errorf("decoding hooks response: %w", err)
+	}
+
+	return &hooks, nil
+}
+
+// UpdateHooks replaces the interface-level hook scripts.
+func (c *WGEasyClient) UpdateHooks(ctx context.Context, req Hooks) (*Hooks, error) {
+	resp, err := c.doIdempotentRequest(ctx, http.MethodPost, "/api/admin/hooks", req)
+	if err != nil {
+		return nil, fmt.Errorf("updating hooks: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status %d updating hooks: %s", resp.StatusCode, string(respBody))
+	}
+
+	// Read back the updated hooks to get server-authoritative values.
+	return c.GetHooks(ctx)
+}
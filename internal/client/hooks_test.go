@@ -0,0 +1,63 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGetHooks(t *testing.T) {
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/admin/hooks" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(Hooks{PostUp: "iptables -A FORWARD -i wg0 -j ACCEPT"})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	hooks, err := client.GetHooks(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hooks.PostUp != "iptables -A FORWARD -i wg0 -j ACCEPT" {
+		t.Errorf("unexpected postUp: '%s'", hooks.PostUp)
+	}
+}
+
+func TestUpdateHooks(t *testing.T) {
+	var current Hooks
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/admin/hooks" && r.Method == http.MethodPost {
+			json.NewDecoder(r.Body).Decode(&current)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/admin/hooks" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(current)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	updated, err := client.UpdateHooks(context.Background(), Hooks{PostUp: "up", PostDown: "down"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.PostUp != "up" || updated.PostDown != "down" {
+		t.Errorf("unexpected hooks: %+v", updated)
+	}
+}
@@ -0,0 +1,65 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// GetInterface returns the server-side WireGuard interface settings.
+func (c *WGEasyClient) GetInterface(ctx context.Context) (*Interface, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/api/admin/interface", nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching interface: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status %d fetching interface: %s", resp.StatusCode, string(respBody))
+	}
+
+	var iface Interface
+	if err := json.NewDecoder(resp.Body).Decode(&iface); err != nil {
+		return nil, fmt.Errorf("decoding interface response: %w", err)
+	}
+
+	return &iface, nil
+}
+
+// UpdateInterface updates the server-side WireGuard interface settings.
+func (c *WGEasyClient) UpdateInterface(ctx context.Context, req UpdateInterfaceRequest) (*Interface, error) {
+	resp, err := c.doIdempotentRequest(ctx, http.MethodPost, "/api/admin/interface", req)
+	if err != nil {
+		return nil, fmt.Errorf("updating interface: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status %d updating interface: %s", resp.StatusCode, string(respBody))
+	}
+
+	// Read back the updated interface to get server-authoritative values.
+	return c.GetInterface(ctx)
+}
+
+// UpdateInterfaceCIDR changes the IPv4/IPv6 address ranges of the interface.
+// wg-easy re-addresses all existing clients into the new ranges.
+func (c *WGEasyClient) UpdateInterfaceCIDR(ctx context.Context, req UpdateInterfaceCIDRRequest) (*Interface, error) {
+	resp, err := c.doIdempotentRequest(ctx, http.MethodPost, "/api/admin/interface/cidr", req)
+	if err != nil {
+		return nil, fmt.Errorf("updating interface CIDR: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status %d updating interface CIDR: %s", resp.StatusCode, string(respBody))
+	}
+
+	return c.GetInterface(ctx)
+}
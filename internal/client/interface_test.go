@@ -0,0 +1,114 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGetInterface(t *testing.T) {
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/admin/interface" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(Interface{
+				Name:     "wg0",
+				Device:   "eth0",
+				Port:     51820,
+				IPv4CIDR: "10.8.0.0/24",
+				MTU:      1420,
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	iface, err := client.GetInterface(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if iface.Name != "wg0" || iface.Port != 51820 || iface.IPv4CIDR != "10.8.0.0/24" {
+		t.Errorf("unexpected interface: %+v", iface)
+	}
+}
+
+func TestUpdateInterface(t *testing.T) {
+	current := Interface{Name: "wg0", Device: "eth0", Port: 51820, MTU: 1420}
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/admin/interface" && r.Method == http.MethodPost {
+			var req UpdateInterfaceRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Port != 51821 || req.Device != "ens3" {
+				t.Errorf("unexpected update request: %+v", req)
+			}
+			current.Port = req.Port
+			current.Device = req.Device
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/admin/interface" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(current)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	updated, err := client.UpdateInterface(context.Background(), UpdateInterfaceRequest{Device: "ens3", Port: 51821, MTU: 1420})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Port != 51821 {
+		t.Errorf("expected port 51821, got %d", updated.Port)
+	}
+	if updated.Device != "ens3" {
+		t.Errorf("expected device 'ens3', got '%s'", updated.Device)
+	}
+}
+
+func TestUpdateInterfaceCIDR(t *testing.T) {
+	current := Interface{Name: "wg0", IPv4CIDR: "10.8.0.0/24", IPv6CIDR: "fdcc:ad94:bacf:61a4::cafe:0/112"}
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/admin/interface/cidr" && r.Method == http.MethodPost {
+			var req UpdateInterfaceCIDRRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			current.IPv4CIDR = req.IPv4CIDR
+			current.IPv6CIDR = req.IPv6CIDR
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/admin/interface" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(current)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	updated, err := client.UpdateInterfaceCIDR(context.Background(), UpdateInterfaceCIDRRequest{
+		IPv4CIDR: "10.9.0.0/24",
+		IPv6CIDR: current.IPv6CIDR,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.IPv4CIDR != "10.9.0.0/24" {
+		t.Errorf("expected CIDR '10.9.0.0/24', got '%s'", updated.IPv4CIDR)
+	}
+}
@@ -0,0 +1,40 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+)
+
+// GenerateKeyPair returns a new base64-encoded WireGuard private/public keypair,
+// equivalent to `wg genkey | tee private | wg pubkey`.
+func GenerateKeyPair() (privateKey, publicKey string, err error) {
+	var raw [32]byte
+	if _, err := rand.Read(raw[:]); err != nil {
+		return "", "", fmt.Errorf("generating private key: %w", err)
+	}
+	// Clamp the scalar the same way wg genkey does.
+	raw[0] &= 248
+	raw[31] = (raw[31] & 127) | 64
+
+	key, err := ecdh.X25519().NewPrivateKey(raw[:])
+	if err != nil {
+		return "", "", fmt.Errorf("deriving public key: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(key.Bytes()),
+		base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()),
+		nil
+}
+
+// GeneratePresharedKey returns a new base64-encoded WireGuard preshared key,
+// equivalent to `wg genpsk`.
+func GeneratePresharedKey() (string, error) {
+	var raw [32]byte
+	if _, err := rand.Read(raw[:]); err != nil {
+		return "", fmt.Errorf("generating preshared key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(raw[:]), nil
+}
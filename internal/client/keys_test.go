@@ -0,0 +1,49 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"encoding/base64"
+	"testing"
+)
+
+func TestGenerateKeyPair(t *testing.T) {
+	privateKey, publicKey, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rawPrivate, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil || len(rawPrivate) != 32 {
+		t.Fatalf("expected 32-byte base64 private key, got %q", privateKey)
+	}
+	if rawPrivate[0]&7 != 0 || rawPrivate[31]&128 != 0 || rawPrivate[31]&64 == 0 {
+		t.Errorf("private key is not clamped: %x", rawPrivate)
+	}
+
+	key, err := ecdh.X25519().NewPrivateKey(rawPrivate)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rawPublic, _ := base64.StdEncoding.DecodeString(publicKey)
+	if !bytes.Equal(key.PublicKey().Bytes(), rawPublic) {
+		t.Errorf("public key %q does not match private key", publicKey)
+	}
+
+	otherPrivate, _, _ := GenerateKeyPair()
+	if otherPrivate == privateKey {
+		t.Error("expected distinct keys on each call")
+	}
+}
+
+func TestGeneratePresharedKey(t *testing.T) {
+	psk, err := GeneratePresharedKey()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	raw, err := base64.StdEncoding.DecodeString(psk)
+	if err != nil || len(raw) != 32 {
+		t.Errorf("expected 32-byte base64 preshared key, got %q", psk)
+	}
+}
@@ -0,0 +1,59 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// FindClient returns the single client in clients whose ID, name or public
+// key, whichever is non-empty, equals the given value. Exactly one of them
+// must be given. A missing ID yields a *NotFoundError; names and public keys
+// are not unique in wg-easy, so several matches are an error listing their IDs.
+func FindClient(clients []Client, id, name, publicKey string) (*Client, error) {
+	var (
+		attr  string
+		want  string
+		field func(Client) string
+		given int
+	)
+	if id != "" {
+		attr, want, field = "ID", id, func(c Client) string { return c.ID.String() }
+		given++
+	}
+	if name != "" {
+		attr, want, field = "name", name, func(c Client) string { return c.Name }
+		given++
+	}
+	if publicKey != "" {
+		attr, want, field = "public key", publicKey, func(c Client) string { return c.PublicKey }
+		given++
+	}
+	if given != 1 {
+		return nil, errors.New("exactly one of ID, name or public key must be given to find a client")
+	}
+
+	var matches []Client
+	for _, c := range clients {
+		if field(c) == want {
+			matches = append(matches, c)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		if id != "" {
+			return nil, &NotFoundError{ID: id}
+		}
+		return nil, fmt.Errorf("no client has %s %q", attr, want)
+	case 1:
+		return &matches[0], nil
+	}
+	ids := make([]string, len(matches))
+	for i, c := range matches {
+		ids[i] = c.ID.String()
+	}
+	return nil, fmt.Errorf("%d clients have %s %q (IDs %s); look the client up by ID instead",
+		len(matches), attr, want, strings.Join(ids, ", "))
+}
@@ -0,0 +1,60 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"strings"
+	"testing"
+)
+
+var lookupTestClients = []Client{
+	{ID: "1", Name: "laptop", PublicKey: "pub-1"},
+	{ID: "2", Name: "phone", PublicKey: "pub-2"},
+	{ID: "3", Name: "phone", PublicKey: "pub-3"},
+}
+
+func TestFindClient(t *testing.T) {
+	tests := []struct {
+		desc                string
+		id, name, publicKey string
+		wantID              string
+	}{
+		{"by ID", "2", "", "", "2"},
+		{"by name", "", "laptop", "", "1"},
+		{"by public key", "", "", "pub-3", "3"},
+	}
+	for _, tt := range tests {
+		c, err := FindClient(lookupTestClients, tt.id, tt.name, tt.publicKey)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.desc, err)
+			continue
+		}
+		if c.ID.String() != tt.wantID {
+			t.Errorf("%s: expected client %s, got %s", tt.desc, tt.wantID, c.ID)
+		}
+	}
+}
+
+func TestFindClientErrors(t *testing.T) {
+	tests := []struct {
+		desc                string
+		id, name, publicKey string
+		want                string
+	}{
+		{"unknown name", "", "tablet", "", `no client has name "tablet"`},
+		{"unknown public key", "", "", "pub-9", `no client has public key "pub-9"`},
+		{"ambiguous name", "", "phone", "", `2 clients have name "phone" (IDs 2, 3)`},
+		{"nothing given", "", "", "", "exactly one of"},
+		{"several given", "1", "laptop", "", "exactly one of"},
+	}
+	for _, tt := range tests {
+		_, err := FindClient(lookupTestClients, tt.id, tt.name, tt.publicKey)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: expected error containing %q, got %v", tt.desc, tt.want, err)
+		}
+	}
+
+	_, err := FindClient(lookupTestClients, "9", "", "")
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Errorf("unknown ID: expected NotFoundError, got %T", err)
+	}
+}
@@ -5,42 +5,43 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 )
 
 // Client represents a WireGuard client/peer as returned by the wg-easy API.
 type Client struct {
-	ID                  FlexibleID `json:"id"`
-	UserID              int64      `json:"userId"`
-	InterfaceID         string     `json:"interfaceId"`
-	Name                string     `json:"name"`
-	Enabled             bool       `json:"enabled"`
-	IPv4Address         string     `json:"ipv4Address"`
-	IPv6Address         string     `json:"ipv6Address"`
-	PublicKey           string     `json:"publicKey"`
-	PrivateKey          string     `json:"privateKey"`
-	PresharedKey        string     `json:"preSharedKey"`
-	ExpiresAt           *string    `json:"expiresAt"`
-	AllowedIPs          []string   `json:"allowedIps"`
-	ServerAllowedIPs    []string   `json:"serverAllowedIps"`
-	DNS                 []string   `json:"dns"`
-	MTU                 int64      `json:"mtu"`
-	PersistentKeepalive int64      `json:"persistentKeepalive"`
-	ServerEndpoint      *string    `json:"serverEndpoint"`
-	PreUp               string     `json:"preUp"`
-	PostUp              string     `json:"postUp"`
-	PreDown             string     `json:"preDown"`
-	PostDown            string     `json:"postDown"`
-	JC                  int64      `json:"jC"`
-	JMin                int64      `json:"jMin"`
-	JMax                int64      `json:"jMax"`
-	I1                  *string    `json:"i1"`
-	I2                  *string    `json:"i2"`
-	I3                  *string    `json:"i3"`
-	I4                  *string    `json:"i4"`
-	I5                  *string    `json:"i5"`
-	OneTimeLink         *string    `json:"oneTimeLink"`
-	CreatedAt           string     `json:"createdAt"`
-	UpdatedAt           string     `json:"updatedAt"`
+	ID                  FlexibleID   `json:"id"`
+	UserID              int64        `json:"userId"`
+	InterfaceID         string       `json:"interfaceId"`
+	Name                string       `json:"name"`
+	Enabled             bool         `json:"enabled"`
+	IPv4Address         string       `json:"ipv4Address"`
+	IPv6Address         string       `json:"ipv6Address"`
+	PublicKey           string       `json:"publicKey"`
+	PrivateKey          string       `json:"privateKey"`
+	PresharedKey        string       `json:"preSharedKey"`
+	ExpiresAt           *string      `json:"expiresAt"`
+	AllowedIPs          []string     `json:"allowedIps"`
+	ServerAllowedIPs    []string     `json:"serverAllowedIps"`
+	DNS                 []string     `json:"dns"`
+	MTU                 int64        `json:"mtu"`
+	PersistentKeepalive int64        `json:"persistentKeepalive"`
+	ServerEndpoint      *string      `json:"serverEndpoint"`
+	PreUp               string       `json:"preUp"`
+	PostUp              string       `json:"postUp"`
+	PreDown             string       `json:"preDown"`
+	PostDown            string       `json:"postDown"`
+	JC                  int64        `json:"jC"`
+	JMin                int64        `json:"jMin"`
+	JMax                int64        `json:"jMax"`
+	I1                  *string      `json:"i1"`
+	I2                  *string      `json:"i2"`
+	I3                  *string      `json:"i3"`
+	I4                  *string      `json:"i4"`
+	I5                  *string      `json:"i5"`
+	OneTimeLink         *OneTimeLink `json:"oneTimeLink"`
+	CreatedAt           string       `json:"createdAt"`
+	UpdatedAt           string       `json:"updatedAt"`
 }
 
 // FlexibleID handles JSON values that may be a string or a number,
@@ -68,6 +69,38 @@ func (f FlexibleID) String() string {
 	return string(f)
 }
 
+// ParseTimestamp parses a wg-easy timestamp: RFC 3339, or the SQLite
+// "YYYY-MM-DD HH:MM:SS" (UTC) form wg-easy stores for some dates such as createdAt.
+func ParseTimestamp(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateTime, value)
+}
+
+// OneTimeLink is a pending one-time download link for a client configuration.
+type OneTimeLink struct {
+	OneTimeLink string `json:"oneTimeLink"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler for OneTimeLink.
+// Older wg-easy versions return the bare link token instead of an object.
+func (l *OneTimeLink) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*l = OneTimeLink{OneTimeLink: s}
+		return nil
+	}
+	type plain OneTimeLink
+	var p plain
+	if err := json.Unmarshal(data, &p); err != nil {
+		return fmt.Errorf("oneTimeLink is neither string nor object: %s", string(data))
+	}
+	*l = OneTimeLink(p)
+	return nil
+}
+
 // CreateClientRequest is the body for POST /api/client.
 type CreateClientRequest struct {
 	Name      string  `json:"name"`
@@ -108,4 +141,89 @@ type UpdateClientRequest struct {
 	I3             *string  `json:"i3"`
 	I4             *string  `json:"i4"`
 	I5             *string  `json:"i5"`
+	// Optional key overrides - omitted to keep the current keys
+	PrivateKey   *string `json:"privateKey,omitempty"`
+	PublicKey    *string `json:"publicKey,omitempty"`
+	PresharedKey *string `json:"preSharedKey,omitempty"`
+}
+
+// Interface represents the server-side WireGuard interface as returned by the wg-easy API.
+type Interface struct {
+	Name      string `json:"name"`
+	Device    string `json:"device"`
+	Port      int64  `json:"port"`
+	PublicKey string `json:"publicKey"`
+	IPv4CIDR  string `json:"ipv4Cidr"`
+	IPv6CIDR  string `json:"ipv6Cidr"`
+	MTU       int64  `json:"mtu"`
+	JC        int64  `json:"jC"`
+	JMin      int64  `json:"jMin"`
+	JMax      int64  `json:"jMax"`
+	S1        int64  `json:"s1"`
+	S2        int64  `json:"s2"`
+	H1        int64  `json:"h1"`
+	H2        int64  `json:"h2"`
+	H3        int64  `json:"h3"`
+	H4        int64  `json:"h4"`
+	Enabled   bool   `json:"enabled"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// UpdateInterfaceRequest is the body for POST /api/admin/interface.
+// ALL fields are required by the API.
+type UpdateInterfaceRequest struct {
+	Device  string `json:"device"`
+	Port    int64  `json:"port"`
+	MTU     int64  `json:"mtu"`
+	JC      int64  `json:"jC"`
+	JMin    int64  `json:"jMin"`
+	JMax    int64  `json:"jMax"`
+	S1      int64  `json:"s1"`
+	S2      int64  `json:"s2"`
+	H1      int64  `json:"h1"`
+	H2      int64  `json:"h2"`
+	H3      int64  `json:"h3"`
+	H4      int64  `json:"h4"`
+	Enabled bool   `json:"enabled"`
+}
+
+// UpdateInterfaceCIDRRequest is the body for POST /api/admin/interface/cidr.
+type UpdateInterfaceCIDRRequest struct {
+	IPv4CIDR string `json:"ipv4Cidr"`
+	IPv6CIDR string `json:"ipv6Cidr"`
+}
+
+// UserConfig represents the defaults wg-easy applies to client configurations,
+// as returned by the admin user-config endpoint.
+type UserConfig struct {
+	ID                         string   `json:"id"`
+	Host                       string   `json:"host"`
+	Port                       int64    `json:"port"`
+	DefaultMTU                 int64    `json:"defaultMtu"`
+	DefaultPersistentKeepalive int64    `json:"defaultPersistentKeepalive"`
+	DefaultDNS                 []string `json:"defaultDns"`
+	DefaultAllowedIPs          []string `json:"defaultAllowedIps"`
+	CreatedAt                  string   `json:"createdAt"`
+	UpdatedAt                  string   `json:"updatedAt"`
+}
+
+// UpdateUserConfigRequest is the body for POST /api/admin/userconfig.
+// ALL fields are required by the API.
+type UpdateUserConfigRequest struct {
+	Host                       string   `json:"host"`
+	Port                       int64    `json:"port"`
+	DefaultMTU                 int64    `json:"defaultMtu"`
+	DefaultPersistentKeepalive int64    `json:"defaultPersistentKeepalive"`
+	DefaultDNS                 []string `json:"defaultDns"`
+	DefaultAllowedIPs          []string `json:"defaultAllowedIps"`
+}
+
+// Hooks represents the interface-level PreUp/PostUp/PreDown/PostDown scripts
+// as returned by the wg-easy API. The same shape is used as the update body.
+type Hooks struct {
+	PreUp    string `json:"preUp"`
+	PostUp   string `json:"postUp"`
+	PreDown  string `json:"preDown"`
+	PostDown string `json:"postDown"`
 }
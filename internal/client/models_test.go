@@ -0,0 +1,31 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Time
+		ok    bool
+	}{
+		{"2026-03-01T10:00:00Z", time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC), true},
+		{"2026-03-01T12:00:00+02:00", time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC), true},
+		{"2026-03-01 10:00:00", time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC), true},
+		{"2026-03-01", time.Time{}, false},
+		{"", time.Time{}, false},
+	}
+	for _, tt := range tests {
+		got, err := ParseTimestamp(tt.value)
+		if (err == nil) != tt.ok {
+			t.Errorf("ParseTimestamp(%q) error = %v, want ok=%v", tt.value, err, tt.ok)
+			continue
+		}
+		if tt.ok && !got.Equal(tt.want) {
+			t.Errorf("ParseTimestamp(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how idempotent requests are retried on transient
+// failures: timeouts, refused or reset connections, and 429/502/503/504
+// responses. Certificate and TLS handshake errors are never retried.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt. Zero disables retries.
+	MaxRetries int
+	// MinBackoff is the delay before the first retry; it doubles on every further retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the exponential delay. A Retry-After header from the server takes precedence.
+	MaxBackoff time.Duration
+}
+
+// WithRetryPolicy enables retries of idempotent requests.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *WGEasyClient) {
+		c.retry = policy
+	}
+}
+
+// doRequestWithRetry performs a request, retrying transient failures when the
+// request is idempotent and the retry policy allows it.
+func (c *WGEasyClient) doRequestWithRetry(ctx context.Context, method, path string, body interface{}, idempotent bool) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.doAuthenticatedRequest(ctx, method, path, body)
+		if !idempotent || attempt >= c.retry.MaxRetries || ctx.Err() != nil || !isTransient(resp, err) {
+			return resp, err
+		}
+
+		wait := c.retry.backoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				wait = retryAfter
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// isTransient reports whether a failed attempt is worth retrying.
+func isTransient(resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		// *url.Error implements net.Error, so a bare errors.As would also
+		// match certificate and handshake failures that never heal on retry.
+		var (
+			unknownAuthority x509.UnknownAuthorityError
+			hostnameErr      x509.HostnameError
+			certInvalid      x509.CertificateInvalidError
+			verifyErr        *tls.CertificateVerificationError
+			recordHeaderErr  tls.RecordHeaderError
+			alertErr         tls.AlertError
+		)
+		if errors.As(err, &unknownAuthority) || errors.As(err, &hostnameErr) || errors.As(err, &certInvalid) ||
+			errors.As(err, &verifyErr) || errors.As(err, &recordHeaderErr) || errors.As(err, &alertErr) {
+			return false
+		}
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return true
+		}
+		return errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry (0-based): exponential,
+// capped at MaxBackoff, with jitter spreading it over [d/2, d].
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff
+	for i := 0; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + rand.N(d-half+1) // #nosec G404 -- jitter does not need a secure source
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
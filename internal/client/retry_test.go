@@ -0,0 +1,300 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+func setupRetryTestServer(t *testing.T, handler http.HandlerFunc) *WGEasyClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewWGEasyClient(server.URL, "admin", "secret", WithRetryPolicy(testRetryPolicy))
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	return client
+}
+
+func TestRetryFlappingServer(t *testing.T) {
+	var calls atomic.Int32
+	client := setupRetryTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client" && r.Method == http.MethodGet {
+			switch calls.Add(1) {
+			case 1:
+				w.WriteHeader(http.StatusBadGateway)
+			case 2:
+				w.WriteHeader(http.StatusServiceUnavailable)
+			default:
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode([]Client{{ID: "abc-123", Name: "test"}})
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	clients, err := client.GetClients(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(clients) != 1 {
+		t.Fatalf("expected 1 client, got %d", len(clients))
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls.Load())
+	}
+}
+
+func TestRetryConnectionReset(t *testing.T) {
+	var calls atomic.Int32
+	client := setupRetryTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if calls.Add(1) == 1 {
+			// Drop the connection without answering, like a restarting container.
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				conn.Close()
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Client{})
+	})
+
+	if _, err := client.GetClients(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 attempts, got %d", calls.Load())
+	}
+}
+
+func TestRetryUntrustedCertificateNotRetried(t *testing.T) {
+	var conns atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("handshake should have failed, got request %s %s", r.Method, r.URL.Path)
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	// The client does not trust the test server's self-signed certificate.
+	client, err := NewWGEasyClient(server.URL, "admin", "secret", WithRetryPolicy(testRetryPolicy))
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	_, err = client.GetClients(context.Background())
+	if err == nil {
+		t.Fatal("expected certificate error")
+	}
+	var unknownAuthority x509.UnknownAuthorityError
+	if !errors.As(err, &unknownAuthority) {
+		t.Errorf("expected x509.UnknownAuthorityError, got %v", err)
+	}
+	if conns.Load() != 1 {
+		t.Errorf("expected exactly 1 attempt, got %d", conns.Load())
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"timeout", &url.Error{Op: "Get", URL: "https://wg", Err: &net.OpError{Op: "dial", Err: timeoutError{}}}, true},
+		{"connection refused", &url.Error{Op: "Get", URL: "https://wg", Err: &net.OpError{Op: "dial", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}}, true},
+		{"connection reset", &url.Error{Op: "Get", URL: "https://wg", Err: &net.OpError{Op: "read", Err: os.NewSyscallError("read", syscall.ECONNRESET)}}, true},
+		{"eof", &url.Error{Op: "Get", URL: "https://wg", Err: io.EOF}, true},
+		{"unknown authority", &url.Error{Op: "Get", URL: "https://wg", Err: x509.UnknownAuthorityError{}}, false},
+		{"hostname mismatch", &url.Error{Op: "Get", URL: "https://wg", Err: x509.HostnameError{}}, false},
+		{"tls alert", &url.Error{Op: "Get", URL: "https://wg", Err: tls.AlertError(42)}, false},
+		{"unsupported scheme", &url.Error{Op: "Get", URL: "ftp://wg", Err: errors.New(`unsupported protocol scheme "ftp"`)}, false},
+		{"canceled", &url.Error{Op: "Get", URL: "https://wg", Err: context.Canceled}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransient(nil, tt.err); got != tt.want {
+				t.Errorf("isTransient(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestRetryGivesUp(t *testing.T) {
+	var calls atomic.Int32
+	client := setupRetryTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	if _, err := client.GetClients(context.Background()); err == nil {
+		t.Fatal("expected error after exhausting retries")
+	}
+	if calls.Load() != int32(testRetryPolicy.MaxRetries+1) {
+		t.Errorf("expected %d attempts, got %d", testRetryPolicy.MaxRetries+1, calls.Load())
+	}
+}
+
+func TestRetryUpdateClientReplayed(t *testing.T) {
+	var updates atomic.Int32
+	client := setupRetryTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client/abc-123" && r.Method == http.MethodPost {
+			var req UpdateClientRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.Name != "updated" {
+				t.Errorf("replayed request lost its body: %+v", req)
+			}
+			if updates.Add(1) == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/client/abc-123" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(Client{ID: "abc-123", Name: "updated"})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	if _, err := client.UpdateClient(context.Background(), "abc-123", UpdateClientRequest{Name: "updated"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updates.Load() != 2 {
+		t.Errorf("expected 2 update attempts, got %d", updates.Load())
+	}
+}
+
+func TestRetryCreateClientNotReplayed(t *testing.T) {
+	var creates atomic.Int32
+	client := setupRetryTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		creates.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	if _, err := client.CreateClient(context.Background(), CreateClientRequest{Name: "new"}); err == nil {
+		t.Fatal("expected error")
+	}
+	if creates.Load() != 1 {
+		t.Errorf("expected create not to be retried, got %d attempts", creates.Load())
+	}
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	var first, second time.Time
+	client := setupRetryTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if calls.Add(1) == 1 {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		second = time.Now()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Client{})
+	})
+
+	if _, err := client.GetClients(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if wait := second.Sub(first); wait < time.Second {
+		t.Errorf("expected to wait for Retry-After (1s), waited %s", wait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"5", 5 * time.Second, true},
+		{"-1", 0, false},
+		{"Thu, 01 Jan 2026 12:00:10 GMT", 10 * time.Second, true},
+		{"Thu, 01 Jan 2026 11:59:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.value, now)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q) = %s, %v; want %s, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 10, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, maxWant := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		maxWant *= time.Millisecond
+		got := policy.backoff(attempt)
+		if got < maxWant/2 || got > maxWant {
+			t.Errorf("backoff(%d) = %s, want within [%s, %s]", attempt, got, maxWant/2, maxWant)
+		}
+	}
+}
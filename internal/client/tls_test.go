@@ -0,0 +1,155 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// emptyListHandler accepts any login and serves an empty client list.
+func emptyListHandler(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/session" {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if r.URL.Path == "/api/client" && r.Method == http.MethodGet {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Client{})
+		return
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func serverCAPEM(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+// generateClientCert returns a self-signed client certificate and key in PEM form.
+func generateClientCert(t *testing.T) (certPEM, keyPEM string, cert *x509.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+	cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parsing certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshaling key: %v", err)
+	}
+	certPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return certPEM, keyPEM, cert
+}
+
+func newTLSTestClient(t *testing.T, url string, opts TLSOptions) *WGEasyClient {
+	t.Helper()
+	tlsConfig, err := NewTLSConfig(opts)
+	if err != nil {
+		t.Fatalf("building TLS config: %v", err)
+	}
+	client, err := NewWGEasyClient(url, "admin", "secret", WithTLSConfig(tlsConfig))
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	return client
+}
+
+func TestTLSUnknownAuthority(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(emptyListHandler))
+	t.Cleanup(server.Close)
+
+	client := newTLSTestClient(t, server.URL, TLSOptions{})
+	if _, err := client.GetClients(context.Background()); err == nil {
+		t.Fatal("expected certificate verification to fail")
+	}
+}
+
+func TestTLSCustomCA(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(emptyListHandler))
+	t.Cleanup(server.Close)
+
+	client := newTLSTestClient(t, server.URL, TLSOptions{CACertPEMs: []string{serverCAPEM(server)}})
+	if _, err := client.GetClients(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTLSInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(emptyListHandler))
+	t.Cleanup(server.Close)
+
+	client := newTLSTestClient(t, server.URL, TLSOptions{InsecureSkipVerify: true})
+	if _, err := client.GetClients(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTLSClientCertificate(t *testing.T) {
+	certPEM, keyPEM, cert := generateClientCert(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(emptyListHandler))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert)
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	withoutCert := newTLSTestClient(t, server.URL, TLSOptions{CACertPEMs: []string{serverCAPEM(server)}})
+	if _, err := withoutCert.GetClients(context.Background()); err == nil {
+		t.Fatal("expected handshake without a client certificate to fail")
+	}
+
+	withCert := newTLSTestClient(t, server.URL, TLSOptions{
+		CACertPEMs:    []string{serverCAPEM(server)},
+		ClientCertPEM: certPEM,
+		ClientKeyPEM:  keyPEM,
+	})
+	if _, err := withCert.GetClients(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNewTLSConfigErrors(t *testing.T) {
+	certPEM, _, _ := generateClientCert(t)
+	tests := map[string]TLSOptions{
+		"invalid CA":          {CACertPEMs: []string{"not a certificate"}},
+		"cert without key":    {ClientCertPEM: certPEM},
+		"key without cert":    {ClientKeyPEM: "key"},
+		"mismatched key pair": {ClientCertPEM: certPEM, ClientKeyPEM: "not a key"},
+	}
+	for name, opts := range tests {
+		if _, err := NewTLSConfig(opts); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"encoding/base32"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the SHA-1 key from the RFC 6238 test vectors, base32-encoded.
+var rfc6238Secret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 appendix B, truncated to 6 digits.
+	tests := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for unix, want := range tests {
+		got, err := totpCode(rfc6238Secret, time.Unix(unix, 0))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != want {
+			t.Errorf("totpCode at %d = %s, want %s", unix, got, want)
+		}
+	}
+}
+
+func TestTOTPCodeNormalizesSecret(t *testing.T) {
+	spaced := strings.ToLower(strings.TrimRight(rfc6238Secret, "="))
+	spaced = spaced[:8] + " " + spaced[8:]
+	got, err := totpCode(spaced, time.Unix(59, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "287082" {
+		t.Errorf("expected 287082, got %s", got)
+	}
+
+	if _, err := totpCode("not base32!", time.Now()); err == nil {
+		t.Error("expected error for invalid secret")
+	}
+}
+
+func totpLoginHandler(t *testing.T, secret string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/session" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		code, ok := body["totpCode"].(string)
+		if !ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"status":"TOTP_REQUIRED"}`))
+			return
+		}
+		want, err := totpCode(secret, time.Now())
+		if err != nil {
+			t.Errorf("computing expected code: %v", err)
+		}
+		if code != want {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"status":"INVALID_TOTP_CODE"}`))
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"success"}`))
+	}
+}
+
+func TestLoginWithTOTP(t *testing.T) {
+	_, client := setupTestServer(t, totpLoginHandler(t, rfc6238Secret))
+	WithTOTPSecret(rfc6238Secret)(client)
+
+	if err := client.login(context.Background()); err != nil {
+		t.Fatalf("expected successful login, got: %v", err)
+	}
+}
+
+func TestLoginTOTPRequired(t *testing.T) {
+	_, client := setupTestServer(t, totpLoginHandler(t, rfc6238Secret))
+
+	err := client.login(context.Background())
+	authErr, ok := err.(*AuthenticationError)
+	if !ok {
+		t.Fatalf("expected AuthenticationError, got: %T", err)
+	}
+	if !strings.Contains(authErr.Message, "totp_secret") {
+		t.Errorf("expected error to mention totp_secret, got %q", authErr.Message)
+	}
+}
+
+func TestLoginInvalidTOTP(t *testing.T) {
+	_, client := setupTestServer(t, totpLoginHandler(t, rfc6238Secret))
+	WithTOTPSecret(base32.StdEncoding.EncodeToString([]byte("another-secret")))(client)
+
+	err := client.login(context.Background())
+	if _, ok := err.(*AuthenticationError); !ok {
+		t.Fatalf("expected AuthenticationError, got: %T", err)
+	}
+}
@@ -0,0 +1,36 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+// WithProxyURL routes every request through the HTTP(S) proxy at proxyURL,
+// overriding the HTTP_PROXY/HTTPS_PROXY/NO_PROXY environment variables.
+func WithProxyURL(proxyURL *url.URL) Option {
+	return func(c *WGEasyClient) {
+		c.transport().Proxy = http.ProxyURL(proxyURL)
+	}
+}
+
+// WithUnixSocket connects to wg-easy through the Unix domain socket at path
+// instead of over TCP. The endpoint still supplies the scheme, Host header
+// and path prefix. Proxies are not used.
+func WithUnixSocket(path string) Option {
+	return func(c *WGEasyClient) {
+		t := c.transport()
+		t.Proxy = nil
+		t.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", path)
+		}
+	}
+}
+
+// transport returns the client's own HTTP transport, which options may modify.
+func (c *WGEasyClient) transport() *http.Transport {
+	return c.httpClient.Transport.(*http.Transport)
+}
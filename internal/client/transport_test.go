@@ -0,0 +1,78 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+)
+
+func TestProxyURL(t *testing.T) {
+	var proxiedHosts []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A forward proxy sees absolute request URLs; answer on wg-easy's behalf.
+		proxiedHosts = append(proxiedHosts, r.URL.Host)
+		emptyListHandler(w, r)
+	}))
+	t.Cleanup(proxy.Close)
+
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatalf("parsing proxy URL: %v", err)
+	}
+	client, err := NewWGEasyClient("http://wg-easy.invalid:51821", "admin", "secret", WithProxyURL(proxyURL))
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	if _, err := client.GetClients(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(proxiedHosts) != 2 {
+		t.Fatalf("expected login and list to go through the proxy, got %v", proxiedHosts)
+	}
+	for _, host := range proxiedHosts {
+		if host != "wg-easy.invalid:51821" {
+			t.Errorf("expected proxied host wg-easy.invalid:51821, got %q", host)
+		}
+	}
+}
+
+func TestUnixSocket(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "wg-easy.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Skipf("unix sockets unavailable: %v", err)
+	}
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/client" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode([]Client{{ID: "abc-123", Name: "over-socket"}})
+			return
+		}
+		emptyListHandler(w, r)
+	}))
+	server.Listener.Close()
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+
+	client, err := NewWGEasyClient("http://localhost", "admin", "secret", WithUnixSocket(socket))
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	clients, err := client.GetClients(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(clients) != 1 || clients[0].Name != "over-socket" {
+		t.Errorf("unexpected clients: %+v", clients)
+	}
+}
@@ -0,0 +1,56 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// GetUserConfig returns the default settings wg-easy applies to client configurations.
+func (c *WGEasyClient) GetUserConfig(ctx context.Context) (*UserConfig, error) {
+	resp, err := c.doRequest(ctx, http.MethodGet, "/api/admin/userconfig", nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching user config: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status %d fetching user config: %s", resp.StatusCode, string(respBody))
+	}
+
+	var userConfig UserConfig
+	if err := json.NewDecoder(resp.Body).Decode(&userConfig); err != nil {
+		return nil, fmt.Errorf("decoding user config response: %w", err)
+	}
+
+	return &userConfig, nil
+}
+
+// UpdateUserConfig updates the default settings wg-easy applies to client configurations.
+func (c *WGEasyClient) UpdateUserConfig(ctx context.Context, req UpdateUserConfigRequest) (*UserConfig, error) {
+	// The default lists are non-nullable - ensure they're arrays, not null.
+	if req.DefaultDNS == nil {
+		req.DefaultDNS = []string{}
+	}
+	if req.DefaultAllowedIPs == nil {
+		req.DefaultAllowedIPs = []string{}
+	}
+
+	resp, err := c.doIdempotentRequest(ctx, http.MethodPost, "/api/admin/userconfig", req)
+	if err != nil {
+		return nil, fmt.Errorf("updating user config: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("unexpected status %d updating user config: %s", resp.StatusCode, string(respBody))
+	}
+
+	// Read back the updated config to get server-authoritative values.
+	return c.GetUserConfig(ctx)
+}
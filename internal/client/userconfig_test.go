@@ -0,0 +1,83 @@
+// Package client provides the HTTP client for interacting with the wg-easy REST API.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGetUserConfig(t *testing.T) {
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/admin/userconfig" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(UserConfig{
+				ID:                "wg0",
+				Host:              "vpn.example.com",
+				Port:              51820,
+				DefaultMTU:        1420,
+				DefaultDNS:        []string{"1.1.1.1"},
+				DefaultAllowedIPs: []string{"0.0.0.0/0", "::/0"},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	userConfig, err := client.GetUserConfig(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if userConfig.Host != "vpn.example.com" || userConfig.Port != 51820 {
+		t.Errorf("unexpected user config: %+v", userConfig)
+	}
+	if len(userConfig.DefaultAllowedIPs) != 2 {
+		t.Errorf("expected 2 default allowed IPs, got %d", len(userConfig.DefaultAllowedIPs))
+	}
+}
+
+func TestUpdateUserConfig(t *testing.T) {
+	current := UserConfig{ID: "wg0", Host: "vpn.example.com", Port: 51820}
+	_, client := setupTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/admin/userconfig" && r.Method == http.MethodPost {
+			var raw map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&raw)
+			if raw["defaultAllowedIps"] == nil {
+				t.Errorf("expected defaultAllowedIps to be an array, got null")
+			}
+			current.Host, _ = raw["host"].(string)
+			current.DefaultDNS = []string{"9.9.9.9"}
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if r.URL.Path == "/api/admin/userconfig" && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(current)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	updated, err := client.UpdateUserConfig(context.Background(), UpdateUserConfigRequest{
+		Host:       "wg.example.com",
+		Port:       51820,
+		DefaultDNS: []string{"9.9.9.9"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Host != "wg.example.com" {
+		t.Errorf("expected host 'wg.example.com', got '%s'", updated.Host)
+	}
+}
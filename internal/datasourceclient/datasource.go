@@ -1,17 +1,22 @@
-// Package datasourceclient implements the wgeasy_client and wgeasy_clients data sources.
+// Package datasourceclient implements the wgeasy_client, wgeasy_clients, wgeasy_client_config and wgeasy_client_qrcode data sources.
 package datasourceclient
 
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ datasource.DataSource = &clientDataSource{}
+var (
+	_ datasource.DataSource                   = &clientDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &clientDataSource{}
+)
 
 type clientDataSource struct {
 	apiClient *client.WGEasyClient
@@ -32,7 +37,7 @@ func (d *clientDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 
 func (d *clientDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches a single WireGuard client/peer by ID from a wg-easy instance.",
+		Description: "Fetches a single WireGuard client/peer from a wg-easy instance by exactly one of id, name or public_key.",
 		Attributes:  clientDataSourceAttributes(true),
 	}
 }
@@ -52,6 +57,34 @@ func (d *clientDataSource) Configure(_ context.Context, req datasource.Configure
 	d.apiClient = apiClient
 }
 
+// lookupKeys are the attributes wgeasy_client can be looked up by; exactly one must be set.
+var lookupKeys = []string{"id", "name", "public_key"}
+
+func (d *clientDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config clientDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	set := 0
+	for _, v := range []types.String{config.ID, config.Name, config.PublicKey} {
+		if v.IsUnknown() {
+			// Decided once the value is known.
+			return
+		}
+		if !v.IsNull() {
+			set++
+		}
+	}
+	if set != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid client lookup",
+			fmt.Sprintf("Exactly one of %s must be set to look up a client.", strings.Join(lookupKeys, ", ")),
+		)
+	}
+}
+
 func (d *clientDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state clientDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
@@ -59,10 +92,30 @@ func (d *clientDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	apiClient, err := d.apiClient.GetClient(state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading client", err.Error())
-		return
+	var apiClient *client.Client
+	if !state.ID.IsNull() {
+		c, err := d.apiClient.GetClient(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Error reading client", err.Error())
+			return
+		}
+		apiClient = c
+	} else {
+		clients, err := d.apiClient.GetClients(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading clients", err.Error())
+			return
+		}
+		attr := "name"
+		if state.Name.IsNull() {
+			attr = "public_key"
+		}
+		c, err := client.FindClient(clients, "", state.Name.ValueString(), state.PublicKey.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attr), "Client not found", err.Error())
+			return
+		}
+		apiClient = c
 	}
 
 	mapClientToModel(ctx, apiClient, &state.clientModel, &resp.Diagnostics)
@@ -74,16 +127,18 @@ func (d *clientDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 }
 
 // clientDataSourceAttributes returns the common attributes for client data sources.
-// If idRequired is true, id is a required input; otherwise it's computed.
-func clientDataSourceAttributes(idRequired bool) map[string]schema.Attribute {
+// If lookup is true, id, name and public_key are optional inputs to look the
+// client up by; otherwise all attributes are computed.
+func clientDataSourceAttributes(lookup bool) map[string]schema.Attribute {
 	attrs := map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			Description: "The ID of the client.",
-			Required:    idRequired,
-			Computed:    !idRequired,
+			Optional:    lookup,
+			Computed:    true,
 		},
 		"name": schema.StringAttribute{
 			Description: "The name of the client.",
+			Optional:    lookup,
 			Computed:    true,
 		},
 		"enabled": schema.BoolAttribute{
@@ -100,6 +155,7 @@ func clientDataSourceAttributes(idRequired bool) map[string]schema.Attribute {
 		},
 		"public_key": schema.StringAttribute{
 			Description: "The public key of the client.",
+			Optional:    lookup,
 			Computed:    true,
 		},
 		"private_key": schema.StringAttribute{
@@ -1,13 +1,22 @@
-// Package datasourceclient implements the wgeasy_client and wgeasy_clients data sources.
+// Package datasourceclient implements the wgeasy_client, wgeasy_clients, wgeasy_client_config and wgeasy_client_qrcode data sources.
 package datasourceclient
 
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSource = &clientsDataSource{}
@@ -17,7 +26,13 @@ type clientsDataSource struct {
 }
 
 type clientsDataSourceModel struct {
-	Clients []clientModel `tfsdk:"clients"`
+	NameRegex     types.String  `tfsdk:"name_regex"`
+	Enabled       types.Bool    `tfsdk:"enabled"`
+	Expired       types.Bool    `tfsdk:"expired"`
+	IPv4CIDR      types.String  `tfsdk:"ipv4_cidr"`
+	ExpiresBefore types.String  `tfsdk:"expires_before"`
+	SortBy        types.String  `tfsdk:"sort_by"`
+	Clients       []clientModel `tfsdk:"clients"`
 }
 
 // NewClientsDataSource creates a new wgeasy_clients data source instance.
@@ -31,10 +46,37 @@ func (d *clientsDataSource) Metadata(_ context.Context, req datasource.MetadataR
 
 func (d *clientsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches all WireGuard clients/peers from a wg-easy instance.",
+		Description: "Fetches WireGuard clients/peers from a wg-easy instance, optionally filtered and sorted.",
 		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Only return clients whose name matches this regular expression (RE2 syntax).",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Only return enabled (true) or disabled (false) clients.",
+				Optional:    true,
+			},
+			"expired": schema.BoolAttribute{
+				Description: "Only return clients whose expiry has passed (true) or that have not expired (false).",
+				Optional:    true,
+			},
+			"ipv4_cidr": schema.StringAttribute{
+				Description: "Only return clients whose IPv4 address is inside this CIDR (e.g. 10.8.0.0/28).",
+				Optional:    true,
+			},
+			"expires_before": schema.StringAttribute{
+				Description: "Only return clients that expire before this RFC 3339 timestamp. Clients without an expiry are excluded.",
+				Optional:    true,
+			},
+			"sort_by": schema.StringAttribute{
+				Description: "Sort the clients by one of: " + strings.Join(sortKeys, ", ") + ". Defaults to the server's order.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(sortKeys...),
+				},
+			},
 			"clients": schema.ListNestedAttribute{
-				Description: "List of all WireGuard clients.",
+				Description: "List of matching WireGuard clients.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: clientDataSourceAttributes(false),
@@ -59,22 +101,76 @@ func (d *clientsDataSource) Configure(_ context.Context, req datasource.Configur
 	d.apiClient = apiClient
 }
 
-func (d *clientsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
-	apiClients, err := d.apiClient.GetClients()
+func (d *clientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state clientsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := buildFilters(state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClients, err := d.apiClient.GetClients(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading clients", err.Error())
 		return
 	}
 
-	var state clientsDataSourceModel
-	state.Clients = make([]clientModel, len(apiClients))
-
+	models := make([]clientModel, len(apiClients))
 	for i, apiClient := range apiClients {
-		mapClientToModel(ctx, &apiClient, &state.Clients[i], &resp.Diagnostics)
+		mapClientToModel(ctx, &apiClient, &models[i], &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
+	state.Clients = filterClients(models, filters, time.Now())
+	if sortBy := state.SortBy.ValueString(); sortBy != "" {
+		sortClients(state.Clients, sortBy)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
+
+// buildFilters parses the filter arguments of wgeasy_clients.
+func buildFilters(config clientsDataSourceModel, diags *diag.Diagnostics) clientFilters {
+	var filters clientFilters
+
+	if v := config.NameRegex.ValueString(); v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		}
+		filters.NameRegex = re
+	}
+	if !config.Enabled.IsNull() {
+		enabled := config.Enabled.ValueBool()
+		filters.Enabled = &enabled
+	}
+	if !config.Expired.IsNull() {
+		expired := config.Expired.ValueBool()
+		filters.Expired = &expired
+	}
+	if v := config.IPv4CIDR.ValueString(); v != "" {
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil || !prefix.Addr().Is4() {
+			diags.AddAttributeError(path.Root("ipv4_cidr"), "Invalid ipv4_cidr",
+				fmt.Sprintf("Expected an IPv4 CIDR such as 10.8.0.0/24, got %q.", v))
+		}
+		prefix = prefix.Masked()
+		filters.IPv4CIDR = &prefix
+	}
+	if v := config.ExpiresBefore.ValueString(); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			diags.AddAttributeError(path.Root("expires_before"), "Invalid expires_before",
+				fmt.Sprintf("Expected an RFC 3339 timestamp such as 2025-12-31T00:00:00Z, got %q.", v))
+		}
+		filters.ExpiresBefore = &t
+	}
+
+	return filters
+}
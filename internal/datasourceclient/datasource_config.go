@@ -0,0 +1,81 @@
+// Package datasourceclient implements the wgeasy_client, wgeasy_clients, wgeasy_client_config and wgeasy_client_qrcode data sources.
+package datasourceclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &clientConfigDataSource{}
+
+type clientConfigDataSource struct {
+	apiClient *client.WGEasyClient
+}
+
+type clientConfigDataSourceModel struct {
+	ClientID      types.String `tfsdk:"client_id"`
+	Configuration types.String `tfsdk:"configuration"`
+}
+
+// NewClientConfigDataSource creates a new wgeasy_client_config data source instance.
+func NewClientConfigDataSource() datasource.DataSource {
+	return &clientConfigDataSource{}
+}
+
+func (d *clientConfigDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_config"
+}
+
+func (d *clientConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the rendered WireGuard configuration file of a client/peer from a wg-easy instance.",
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Description: "The ID of the client.",
+				Required:    true,
+			},
+			"configuration": schema.StringAttribute{
+				Description: "The WireGuard configuration file contents, including the client private key.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (d *clientConfigDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	apiClient, ok := req.ProviderData.(*client.WGEasyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.WGEasyClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.apiClient = apiClient
+}
+
+func (d *clientConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state clientConfigDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := d.apiClient.GetClientConfiguration(ctx, state.ClientID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading client configuration", err.Error())
+		return
+	}
+
+	state.Configuration = types.StringValue(configuration)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,122 @@
+// Package datasourceclient implements the wgeasy_client, wgeasy_clients, wgeasy_client_config and wgeasy_client_qrcode data sources.
+package datasourceclient
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+var _ datasource.DataSource = &clientQRCodeDataSource{}
+
+// Bounds of png_size. The PNG is rendered in memory as a size x size bitmap,
+// so an unbounded size can exhaust the provider's memory.
+const (
+	minPNGSize = 64
+	maxPNGSize = 4096
+)
+
+type clientQRCodeDataSource struct {
+	apiClient *client.WGEasyClient
+}
+
+type clientQRCodeDataSourceModel struct {
+	ClientID  types.String `tfsdk:"client_id"`
+	PNGSize   types.Int64  `tfsdk:"png_size"`
+	SVG       types.String `tfsdk:"svg"`
+	PNGBase64 types.String `tfsdk:"png_base64"`
+}
+
+// NewClientQRCodeDataSource creates a new wgeasy_client_qrcode data source instance.
+func NewClientQRCodeDataSource() datasource.DataSource {
+	return &clientQRCodeDataSource{}
+}
+
+func (d *clientQRCodeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_qrcode"
+}
+
+func (d *clientQRCodeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the QR code of a client/peer configuration from a wg-easy instance, for import into the WireGuard mobile apps.",
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Description: "The ID of the client.",
+				Required:    true,
+			},
+			"png_size": schema.Int64Attribute{
+				Description: fmt.Sprintf("If set, also render the QR code locally as a PNG of this width and height in pixels (%d to %d).", minPNGSize, maxPNGSize),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(minPNGSize, maxPNGSize),
+				},
+			},
+			"svg": schema.StringAttribute{
+				Description: "The QR code as an SVG document, as rendered by wg-easy.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"png_base64": schema.StringAttribute{
+				Description: "The QR code as a base64-encoded PNG. Only set when png_size is set.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (d *clientQRCodeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	apiClient, ok := req.ProviderData.(*client.WGEasyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.WGEasyClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	d.apiClient = apiClient
+}
+
+func (d *clientQRCodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state clientQRCodeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientID := state.ClientID.ValueString()
+	svg, err := d.apiClient.GetClientQRCode(ctx, clientID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading client QR code", err.Error())
+		return
+	}
+	state.SVG = types.StringValue(svg)
+	state.PNGBase64 = types.StringNull()
+
+	if !state.PNGSize.IsNull() {
+		configuration, err := d.apiClient.GetClientConfiguration(ctx, clientID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading client configuration", err.Error())
+			return
+		}
+		png, err := qrcode.Encode(configuration, qrcode.Medium, int(state.PNGSize.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Error rendering client QR code", err.Error())
+			return
+		}
+		state.PNGBase64 = types.StringValue(base64.StdEncoding.EncodeToString(png))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
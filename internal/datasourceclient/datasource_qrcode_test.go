@@ -0,0 +1,29 @@
+// Package datasourceclient implements the wgeasy_client, wgeasy_clients, wgeasy_client_config and wgeasy_client_qrcode data sources.
+package datasourceclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPNGSizeValidatedBySchema(t *testing.T) {
+	var resp datasource.SchemaResponse
+	NewClientQRCodeDataSource().Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+	pngSize := resp.Schema.Attributes["png_size"].(schema.Int64Attribute)
+
+	for value, valid := range map[int64]bool{64: true, 512: true, 4096: true, 63: false, 0: false, -512: false, 100000: false} {
+		var vresp validator.Int64Response
+		for _, v := range pngSize.Validators {
+			v.ValidateInt64(context.Background(), validator.Int64Request{Path: path.Root("png_size"), ConfigValue: types.Int64Value(value)}, &vresp)
+		}
+		if vresp.Diagnostics.HasError() == valid {
+			t.Errorf("png_size %d: valid = %v, diagnostics: %v", value, valid, vresp.Diagnostics)
+		}
+	}
+}
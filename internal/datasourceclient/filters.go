@@ -0,0 +1,119 @@
+// Package datasourceclient implements the wgeasy_client, wgeasy_clients, wgeasy_client_config and wgeasy_client_qrcode data sources.
+package datasourceclient
+
+import (
+	"cmp"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+)
+
+// sortKeys lists the values accepted by the sort_by argument of wgeasy_clients.
+var sortKeys = []string{"id", "name", "ipv4_address", "created_at", "expires_at"}
+
+// clientFilters narrows down the clients returned by wgeasy_clients.
+// Nil fields do not filter.
+type clientFilters struct {
+	NameRegex     *regexp.Regexp
+	Enabled       *bool
+	Expired       *bool
+	IPv4CIDR      *netip.Prefix
+	ExpiresBefore *time.Time
+}
+
+// matches reports whether model passes every filter, evaluated at now.
+func (f clientFilters) matches(model clientModel, now time.Time) bool {
+	if f.NameRegex != nil && !f.NameRegex.MatchString(model.Name.ValueString()) {
+		return false
+	}
+	if f.Enabled != nil && model.Enabled.ValueBool() != *f.Enabled {
+		return false
+	}
+	if f.IPv4CIDR != nil {
+		addr, err := netip.ParseAddr(model.IPv4Address.ValueString())
+		if err != nil || !f.IPv4CIDR.Contains(addr) {
+			return false
+		}
+	}
+
+	expiresAt, hasExpiry := modelExpiry(model)
+	if f.Expired != nil && (hasExpiry && !expiresAt.After(now)) != *f.Expired {
+		return false
+	}
+	if f.ExpiresBefore != nil && (!hasExpiry || !expiresAt.Before(*f.ExpiresBefore)) {
+		return false
+	}
+	return true
+}
+
+// filterClients returns the models that pass f, keeping their order.
+func filterClients(models []clientModel, f clientFilters, now time.Time) []clientModel {
+	filtered := make([]clientModel, 0, len(models))
+	for _, model := range models {
+		if f.matches(model, now) {
+			filtered = append(filtered, model)
+		}
+	}
+	return filtered
+}
+
+// sortClients sorts models in place by one of sortKeys, which the schema
+// enforces; any other key leaves the order unchanged. IDs and IPv4 addresses
+// compare numerically; clients without an expiry sort last.
+func sortClients(models []clientModel, by string) {
+	var compare func(a, b clientModel) int
+	switch by {
+	case "id":
+		compare = func(a, b clientModel) int { return compareIDs(a.ID.ValueString(), b.ID.ValueString()) }
+	case "name":
+		compare = func(a, b clientModel) int { return cmp.Compare(a.Name.ValueString(), b.Name.ValueString()) }
+	case "ipv4_address":
+		compare = func(a, b clientModel) int {
+			addrA, _ := netip.ParseAddr(a.IPv4Address.ValueString())
+			addrB, _ := netip.ParseAddr(b.IPv4Address.ValueString())
+			return addrA.Compare(addrB)
+		}
+	case "created_at":
+		compare = func(a, b clientModel) int { return cmp.Compare(a.CreatedAt.ValueString(), b.CreatedAt.ValueString()) }
+	case "expires_at":
+		compare = func(a, b clientModel) int {
+			expA, okA := modelExpiry(a)
+			expB, okB := modelExpiry(b)
+			switch {
+			case !okA && !okB:
+				return 0
+			case !okA:
+				return 1
+			case !okB:
+				return -1
+			}
+			return expA.Compare(expB)
+		}
+	default:
+		return
+	}
+	slices.SortStableFunc(models, compare)
+}
+
+// compareIDs orders numeric IDs numerically and anything else lexically.
+func compareIDs(a, b string) int {
+	numA, errA := strconv.ParseInt(a, 10, 64)
+	numB, errB := strconv.ParseInt(b, 10, 64)
+	if errA == nil && errB == nil {
+		return cmp.Compare(numA, numB)
+	}
+	return cmp.Compare(a, b)
+}
+
+// modelExpiry returns the client's expiry, if it has a parseable one.
+func modelExpiry(model clientModel) (time.Time, bool) {
+	if model.ExpiresAt.IsNull() || model.ExpiresAt.ValueString() == "" {
+		return time.Time{}, false
+	}
+	t, err := client.ParseTimestamp(model.ExpiresAt.ValueString())
+	return t, err == nil
+}
@@ -0,0 +1,155 @@
+// Package datasourceclient implements the wgeasy_client, wgeasy_clients, wgeasy_client_config and wgeasy_client_qrcode data sources.
+package datasourceclient
+
+import (
+	"context"
+	"net/netip"
+	"regexp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var filterTestNow = time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func strPtr(s string) *string { return &s }
+
+// testModels maps a fixed set of clients through mapClientToModel.
+func testModels(t *testing.T) []clientModel {
+	t.Helper()
+	apiClients := []client.Client{
+		{ID: "10", Name: "laptop-alice", Enabled: true, IPv4Address: "10.8.0.10", CreatedAt: "2026-01-03T00:00:00.000Z"},
+		{ID: "2", Name: "phone-alice", Enabled: false, IPv4Address: "10.8.0.2", CreatedAt: "2026-01-01T00:00:00.000Z",
+			ExpiresAt: strPtr("2026-05-01T00:00:00.000Z")},
+		{ID: "3", Name: "laptop-bob", Enabled: true, IPv4Address: "10.8.1.3", CreatedAt: "2026-01-02T00:00:00.000Z",
+			ExpiresAt: strPtr("2026-07-01T00:00:00.000Z")},
+	}
+
+	var diags diag.Diagnostics
+	models := make([]clientModel, len(apiClients))
+	for i, apiClient := range apiClients {
+		mapClientToModel(context.Background(), &apiClient, &models[i], &diags)
+	}
+	if diags.HasError() {
+		t.Fatalf("mapping clients: %v", diags)
+	}
+	return models
+}
+
+func names(models []clientModel) []string {
+	out := make([]string, len(models))
+	for i, m := range models {
+		out[i] = m.Name.ValueString()
+	}
+	return out
+}
+
+func TestFilterClients(t *testing.T) {
+	yes, no := true, false
+	cidr := netip.MustParsePrefix("10.8.0.0/24")
+	before := time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		filters clientFilters
+		want    []string
+	}{
+		"no filters":     {clientFilters{}, []string{"laptop-alice", "phone-alice", "laptop-bob"}},
+		"name_regex":     {clientFilters{NameRegex: regexp.MustCompile("^laptop-")}, []string{"laptop-alice", "laptop-bob"}},
+		"enabled":        {clientFilters{Enabled: &yes}, []string{"laptop-alice", "laptop-bob"}},
+		"disabled":       {clientFilters{Enabled: &no}, []string{"phone-alice"}},
+		"expired":        {clientFilters{Expired: &yes}, []string{"phone-alice"}},
+		"not expired":    {clientFilters{Expired: &no}, []string{"laptop-alice", "laptop-bob"}},
+		"ipv4_cidr":      {clientFilters{IPv4CIDR: &cidr}, []string{"laptop-alice", "phone-alice"}},
+		"expires_before": {clientFilters{ExpiresBefore: &before}, []string{"phone-alice"}},
+		"combined": {
+			clientFilters{NameRegex: regexp.MustCompile("alice"), Enabled: &yes, IPv4CIDR: &cidr},
+			[]string{"laptop-alice"},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := names(filterClients(testModels(t), tt.filters, filterTestNow))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortClients(t *testing.T) {
+	tests := map[string][]string{
+		"id":           {"phone-alice", "laptop-bob", "laptop-alice"},
+		"name":         {"laptop-alice", "laptop-bob", "phone-alice"},
+		"ipv4_address": {"phone-alice", "laptop-alice", "laptop-bob"},
+		"created_at":   {"phone-alice", "laptop-bob", "laptop-alice"},
+		"expires_at":   {"phone-alice", "laptop-bob", "laptop-alice"},
+	}
+	for by, want := range tests {
+		t.Run(by, func(t *testing.T) {
+			models := testModels(t)
+			sortClients(models, by)
+			if got := names(models); !slices.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestSortByValidatedBySchema(t *testing.T) {
+	var resp datasource.SchemaResponse
+	NewClientsDataSource().Schema(context.Background(), datasource.SchemaRequest{}, &resp)
+	sortBy := resp.Schema.Attributes["sort_by"].(schema.StringAttribute)
+
+	for value, valid := range map[string]bool{"name": true, "expires_at": true, "size": false, "Name": false} {
+		var vresp validator.StringResponse
+		for _, v := range sortBy.Validators {
+			v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("sort_by"), ConfigValue: types.StringValue(value)}, &vresp)
+		}
+		if vresp.Diagnostics.HasError() == valid {
+			t.Errorf("sort_by %q: valid = %v, diagnostics: %v", value, valid, vresp.Diagnostics)
+		}
+	}
+}
+
+func TestBuildFilters(t *testing.T) {
+	var diags diag.Diagnostics
+	filters := buildFilters(clientsDataSourceModel{
+		NameRegex:     types.StringValue("^laptop"),
+		Enabled:       types.BoolValue(false),
+		Expired:       types.BoolNull(),
+		IPv4CIDR:      types.StringValue("10.8.0.7/24"),
+		ExpiresBefore: types.StringValue("2026-06-15T00:00:00Z"),
+	}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if filters.Enabled == nil || *filters.Enabled {
+		t.Errorf("expected enabled=false filter, got %v", filters.Enabled)
+	}
+	if filters.Expired != nil {
+		t.Errorf("expected no expired filter, got %v", *filters.Expired)
+	}
+	if filters.IPv4CIDR == nil || filters.IPv4CIDR.String() != "10.8.0.0/24" {
+		t.Errorf("expected masked CIDR 10.8.0.0/24, got %v", filters.IPv4CIDR)
+	}
+
+	for name, config := range map[string]clientsDataSourceModel{
+		"bad regex":     {NameRegex: types.StringValue("(")},
+		"ipv6 cidr":     {IPv4CIDR: types.StringValue("fd00::/64")},
+		"bad timestamp": {ExpiresBefore: types.StringValue("tomorrow")},
+	} {
+		var diags diag.Diagnostics
+		buildFilters(config, &diags)
+		if !diags.HasError() {
+			t.Errorf("%s: expected a diagnostic", name)
+		}
+	}
+}
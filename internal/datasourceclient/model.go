@@ -1,4 +1,4 @@
-// Package datasourceclient implements the wgeasy_client and wgeasy_clients data sources.
+// Package datasourceclient implements the wgeasy_client, wgeasy_clients, wgeasy_client_config and wgeasy_client_qrcode data sources.
 package datasourceclient
 
 import (
@@ -3,11 +3,22 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
 	"github.com/Nastaliss/terraform-provider-wgeasy/internal/datasourceclient"
 	"github.com/Nastaliss/terraform-provider-wgeasy/internal/resourceclient"
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/resourceclientstate"
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/resourcehooks"
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/resourceinterface"
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/resourceonetimelink"
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/resourceuserconfig"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -17,12 +28,36 @@ import (
 
 var _ provider.Provider = &wgeasyProvider{}
 
+// Defaults applied when the corresponding provider attribute and environment variable are unset.
+const (
+	defaultRequestTimeout  = 30 * time.Second
+	defaultMaxRetries      = 3
+	defaultRetryMinBackoff = 1 * time.Second
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
 type wgeasyProvider struct{}
 
 type wgeasyProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	RequestTimeout  types.String `tfsdk:"request_timeout"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	RetryMinBackoff types.String `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff types.String `tfsdk:"retry_max_backoff"`
+	ClientCacheTTL  types.String `tfsdk:"client_cache_ttl"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+
+	ProxyURL   types.String `tfsdk:"proxy_url"`
+	UnixSocket types.String `tfsdk:"unix_socket"`
+	Headers    types.Map    `tfsdk:"headers"`
+	TOTPSecret types.String `tfsdk:"totp_secret"`
 }
 
 // New creates a new wg-easy provider instance.
@@ -51,6 +86,83 @@ func (p *wgeasyProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Maximum duration of a single HTTP request to wg-easy, as a Go duration (e.g. 30s). " +
+					"Defaults to 30s; 0 disables the timeout. Can also be set via WGEASY_REQUEST_TIMEOUT environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "How many times an idempotent request is retried after a connection error or a 429/502/503/504 response. " +
+					"Defaults to 3; 0 disables retries. Can also be set via WGEASY_MAX_RETRIES environment variable.",
+				Optional: true,
+			},
+			"retry_min_backoff": schema.StringAttribute{
+				Description: "Delay before the first retry, as a Go duration. Doubles on every further attempt. " +
+					"Defaults to 1s. Can also be set via WGEASY_RETRY_MIN_BACKOFF environment variable.",
+				Optional: true,
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Description: "Upper bound for the delay between retries, as a Go duration. " +
+					"Defaults to 30s. Can also be set via WGEASY_RETRY_MAX_BACKOFF environment variable.",
+				Optional: true,
+			},
+			"client_cache_ttl": schema.StringAttribute{
+				Description: "How long the client list is shared between resources, as a Go duration (e.g. 10s). " +
+					"Concurrent reads are coalesced into one list request and any write drops the cache. " +
+					"Defaults to 0, which disables the cache. Can also be set via WGEASY_CLIENT_CACHE_TTL environment variable.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA certificate(s) trusted in addition to the system roots when connecting to wg-easy over HTTPS. " +
+					"Can also be set via WGEASY_CA_CERT_PEM environment variable.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM file of CA certificate(s) trusted in addition to the system roots. " +
+					"Can also be set via WGEASY_CA_CERT_FILE environment variable.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the wg-easy server certificate. Only use this for testing. " +
+					"Can also be set via WGEASY_INSECURE_SKIP_VERIFY environment variable.",
+				Optional: true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "PEM-encoded client certificate for mutual TLS. Requires client_key. " +
+					"Can also be set via WGEASY_CLIENT_CERT environment variable.",
+				Optional: true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM-encoded private key for client_cert. " +
+					"Can also be set via WGEASY_CLIENT_KEY environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of an HTTP(S) proxy used for every request, overriding HTTP_PROXY/HTTPS_PROXY/NO_PROXY. " +
+					"Can also be set via WGEASY_PROXY_URL environment variable.",
+				Optional: true,
+			},
+			"unix_socket": schema.StringAttribute{
+				Description: "Path to a Unix domain socket to reach wg-easy through instead of TCP. The endpoint still sets the " +
+					"scheme and Host header (e.g. http://localhost). Conflicts with proxy_url. " +
+					"Can also be set via WGEASY_UNIX_SOCKET environment variable.",
+				Optional: true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "Extra HTTP headers sent with every request, including login, e.g. service tokens for an " +
+					"authenticating reverse proxy. Can also be set via WGEASY_HEADERS environment variable as " +
+					"a JSON object or one Name=value pair per line.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"totp_secret": schema.StringAttribute{
+				Description: "Base32 TOTP secret of the admin account, for accounts with two-factor authentication enabled. " +
+					"The current code is computed at every login. Can also be set via WGEASY_TOTP_SECRET environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
@@ -84,11 +196,114 @@ func (p *wgeasyProvider) Configure(ctx context.Context, req provider.ConfigureRe
 			"The wg-easy password must be set in the provider configuration or via the WGEASY_PASSWORD environment variable.",
 		)
 	}
+
+	requestTimeout := durationValueOrEnv(config.RequestTimeout, "WGEASY_REQUEST_TIMEOUT", "request_timeout", defaultRequestTimeout, resp)
+	minBackoff := durationValueOrEnv(config.RetryMinBackoff, "WGEASY_RETRY_MIN_BACKOFF", "retry_min_backoff", defaultRetryMinBackoff, resp)
+	maxBackoff := durationValueOrEnv(config.RetryMaxBackoff, "WGEASY_RETRY_MAX_BACKOFF", "retry_max_backoff", defaultRetryMaxBackoff, resp)
+	clientCacheTTL := durationValueOrEnv(config.ClientCacheTTL, "WGEASY_CLIENT_CACHE_TTL", "client_cache_ttl", 0, resp)
+	if maxBackoff < minBackoff {
+		resp.Diagnostics.AddError(
+			"Invalid retry_max_backoff",
+			fmt.Sprintf("retry_max_backoff (%s) must not be shorter than retry_min_backoff (%s).", maxBackoff, minBackoff),
+		)
+	}
+
+	maxRetries := int64(defaultMaxRetries)
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	} else if v := os.Getenv("WGEASY_MAX_RETRIES"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid max_retries",
+				fmt.Sprintf("WGEASY_MAX_RETRIES must be an integer, got %q.", v),
+			)
+		}
+		maxRetries = n
+	}
+	if maxRetries < 0 {
+		resp.Diagnostics.AddError("Invalid max_retries", "max_retries must not be negative.")
+	}
+
+	insecureSkipVerify := boolValueOrEnv(config.InsecureSkipVerify, "WGEASY_INSECURE_SKIP_VERIFY", "insecure_skip_verify", resp)
+	tlsOptions := client.TLSOptions{
+		InsecureSkipVerify: insecureSkipVerify,
+		ClientCertPEM:      stringValueOrEnv(config.ClientCert, "WGEASY_CLIENT_CERT"),
+		ClientKeyPEM:       stringValueOrEnv(config.ClientKey, "WGEASY_CLIENT_KEY"),
+	}
+	if v := stringValueOrEnv(config.CACertPEM, "WGEASY_CA_CERT_PEM"); v != "" {
+		tlsOptions.CACertPEMs = append(tlsOptions.CACertPEMs, v)
+	}
+	if v := stringValueOrEnv(config.CACertFile, "WGEASY_CA_CERT_FILE"); v != "" {
+		pem, err := os.ReadFile(v)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid ca_cert_file", fmt.Sprintf("Reading CA certificate file: %s", err))
+		}
+		tlsOptions.CACertPEMs = append(tlsOptions.CACertPEMs, string(pem))
+	}
+
+	proxyURL := stringValueOrEnv(config.ProxyURL, "WGEASY_PROXY_URL")
+	unixSocket := stringValueOrEnv(config.UnixSocket, "WGEASY_UNIX_SOCKET")
+	if proxyURL != "" && unixSocket != "" {
+		resp.Diagnostics.AddError(
+			"Conflicting transport settings",
+			"proxy_url and unix_socket cannot be used together.",
+		)
+	}
+	var parsedProxyURL *url.URL
+	if proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			resp.Diagnostics.AddError(
+				"Invalid proxy_url",
+				fmt.Sprintf("proxy_url must be an absolute URL such as http://proxy.example.com:3128, got %q.", proxyURL),
+			)
+		}
+		parsedProxyURL = u
+	}
+
+	var headers map[string]string
+	if !config.Headers.IsNull() && !config.Headers.IsUnknown() {
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+	} else if v := os.Getenv("WGEASY_HEADERS"); v != "" {
+		h, err := parseHeaders(v)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid WGEASY_HEADERS", err.Error())
+		}
+		headers = h
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	apiClient, err := client.NewWGEasyClient(endpoint, username, password)
+	opts := []client.Option{
+		client.WithRequestTimeout(requestTimeout),
+		client.WithRetryPolicy(client.RetryPolicy{
+			MaxRetries: int(maxRetries),
+			MinBackoff: minBackoff,
+			MaxBackoff: maxBackoff,
+		}),
+		client.WithClientListCache(clientCacheTTL),
+		client.WithHeaders(headers),
+		client.WithTOTPSecret(stringValueOrEnv(config.TOTPSecret, "WGEASY_TOTP_SECRET")),
+	}
+	if len(tlsOptions.CACertPEMs) > 0 || tlsOptions.InsecureSkipVerify || tlsOptions.ClientCertPEM != "" || tlsOptions.ClientKeyPEM != "" {
+		tlsConfig, err := client.NewTLSConfig(tlsOptions)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid TLS configuration", err.Error())
+			return
+		}
+		opts = append(opts, client.WithTLSConfig(tlsConfig))
+	}
+	if parsedProxyURL != nil {
+		opts = append(opts, client.WithProxyURL(parsedProxyURL))
+	}
+	if unixSocket != "" {
+		opts = append(opts, client.WithUnixSocket(unixSocket))
+	}
+
+	apiClient, err := client.NewWGEasyClient(endpoint, username, password, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create API client", err.Error())
 		return
@@ -101,6 +316,11 @@ func (p *wgeasyProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *wgeasyProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		resourceclient.NewClientResource,
+		resourceinterface.NewInterfaceResource,
+		resourceuserconfig.NewUserConfigResource,
+		resourcehooks.NewHooksResource,
+		resourceonetimelink.NewOneTimeLinkResource,
+		resourceclientstate.NewClientStateResource,
 	}
 }
 
@@ -108,6 +328,8 @@ func (p *wgeasyProvider) DataSources(_ context.Context) []func() datasource.Data
 	return []func() datasource.DataSource{
 		datasourceclient.NewClientDataSource,
 		datasourceclient.NewClientsDataSource,
+		datasourceclient.NewClientConfigDataSource,
+		datasourceclient.NewClientQRCodeDataSource,
 	}
 }
 
@@ -117,3 +339,68 @@ func stringValueOrEnv(val types.String, envVar string) string {
 	}
 	return os.Getenv(envVar)
 }
+
+// durationValueOrEnv resolves a duration attribute from config or envVar,
+// falling back to def and reporting unparsable or negative values against attr.
+func durationValueOrEnv(val types.String, envVar, attr string, def time.Duration, resp *provider.ConfigureResponse) time.Duration {
+	v := stringValueOrEnv(val, envVar)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		resp.Diagnostics.AddError(
+			"Invalid "+attr,
+			fmt.Sprintf("%s must be a non-negative Go duration such as 30s or 2m, got %q.", attr, v),
+		)
+		return def
+	}
+	return d
+}
+
+// boolValueOrEnv resolves a bool attribute from config or envVar, reporting
+// unparsable environment values against attr.
+func boolValueOrEnv(val types.Bool, envVar, attr string, resp *provider.ConfigureResponse) bool {
+	if !val.IsNull() && !val.IsUnknown() {
+		return val.ValueBool()
+	}
+	v := os.Getenv(envVar)
+	if v == "" {
+		return false
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid "+attr,
+			fmt.Sprintf("%s must be a boolean, got %q.", envVar, v),
+		)
+	}
+	return b
+}
+
+// parseHeaders parses WGEASY_HEADERS: either a JSON object of header names to
+// values, or one Name=value pair per line. Header values may contain commas
+// and semicolons (e.g. cookies) but never newlines, so lines are unambiguous.
+func parseHeaders(value string) (map[string]string, error) {
+	headers := map[string]string{}
+	if strings.HasPrefix(strings.TrimSpace(value), "{") {
+		if err := json.Unmarshal([]byte(value), &headers); err != nil {
+			return nil, fmt.Errorf("parsing JSON object: %w", err)
+		}
+		return headers, nil
+	}
+
+	for _, line := range strings.Split(value, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		name, val, ok := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("expected one Name=value pair per line, got %q", line)
+		}
+		headers[name] = strings.TrimSpace(val)
+	}
+	return headers, nil
+}
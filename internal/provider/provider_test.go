@@ -0,0 +1,68 @@
+// Package provider implements the wg-easy Terraform provider.
+package provider
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestParseHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "single line",
+			value: "CF-Access-Client-Id=abc",
+			want:  map[string]string{"CF-Access-Client-Id": "abc"},
+		},
+		{
+			name:  "one pair per line",
+			value: "CF-Access-Client-Id=abc\nCF-Access-Client-Secret=xyz\n",
+			want:  map[string]string{"CF-Access-Client-Id": "abc", "CF-Access-Client-Secret": "xyz"},
+		},
+		{
+			name:  "values with commas, semicolons and equals signs",
+			value: "Accept=application/json, text/plain\r\nCookie=session=1; theme=dark",
+			want:  map[string]string{"Accept": "application/json, text/plain", "Cookie": "session=1; theme=dark"},
+		},
+		{
+			name:  "surrounding whitespace and blank lines",
+			value: "\n  X-Token = secret  \n\n",
+			want:  map[string]string{"X-Token": "secret"},
+		},
+		{
+			name:  "JSON object",
+			value: ` {"Accept": "a, b", "Cookie": "session=1; theme=dark"}`,
+			want:  map[string]string{"Accept": "a, b", "Cookie": "session=1; theme=dark"},
+		},
+		{
+			name:    "missing equals sign",
+			value:   "X-Token",
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			value:   "=value",
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			value:   `{"X-Token": 1}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseHeaders(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.wantErr && !maps.Equal(got, tt.want) {
+				t.Errorf("parseHeaders(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
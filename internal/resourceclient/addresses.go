@@ -0,0 +1,92 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// validateAddresses checks statically assigned addresses against the interface
+// CIDRs and the addresses of the other peers, so conflicts fail at plan time.
+// Only addresses that change are checked, to keep refresh-only plans cheap.
+func (r *clientResource) validateAddresses(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var config clientResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state clientResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ipv4Changed := isSetString(config.IPv4Address) && !config.IPv4Address.Equal(state.IPv4Address)
+	ipv6Changed := isSetString(config.IPv6Address) && !config.IPv6Address.Equal(state.IPv6Address)
+	// The API client is nil when the provider is not configured yet (e.g. during validate).
+	if (!ipv4Changed && !ipv6Changed) || r.apiClient == nil {
+		return
+	}
+
+	iface, err := r.apiClient.GetInterface(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading interface to validate client addresses", err.Error())
+		return
+	}
+	clients, err := r.apiClient.GetClients(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading clients to validate client addresses", err.Error())
+		return
+	}
+
+	selfID := state.ID.ValueString()
+	if ipv4Changed {
+		if err := checkAddress(config.IPv4Address.ValueString(), iface.IPv4CIDR, selfID, clients, ipv4Of); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ipv4_address"), "Invalid IPv4 address", err.Error())
+		}
+	}
+	if ipv6Changed {
+		if err := checkAddress(config.IPv6Address.ValueString(), iface.IPv6CIDR, selfID, clients, ipv6Of); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ipv6_address"), "Invalid IPv6 address", err.Error())
+		}
+	}
+}
+
+func ipv4Of(c client.Client) string { return c.IPv4Address }
+
+func ipv6Of(c client.Client) string { return c.IPv6Address }
+
+// checkAddress verifies that address lies inside cidr and is not used by any
+// client other than selfID.
+func checkAddress(address, cidr, selfID string, clients []client.Client, addressOf func(client.Client) string) error {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid IP address", address)
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return fmt.Errorf("interface CIDR %q is not valid: %w", cidr, err)
+	}
+	if !prefix.Contains(addr) {
+		return fmt.Errorf("%s is outside the interface CIDR %s", addr, prefix)
+	}
+
+	for _, c := range clients {
+		if c.ID.String() == selfID {
+			continue
+		}
+		other, err := netip.ParseAddr(addressOf(c))
+		if err == nil && other == addr {
+			return fmt.Errorf("%s is already assigned to client %s (%s)", addr, c.ID, c.Name)
+		}
+	}
+	return nil
+}
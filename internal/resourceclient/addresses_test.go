@@ -0,0 +1,143 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testPeers = []client.Client{
+	{ID: "abc-123", Name: "laptop", IPv4Address: "10.8.0.2", IPv6Address: "fdcc:ad94:bacf:61a4::cafe:2"},
+	{ID: "def-456", Name: "phone", IPv4Address: "10.8.0.3", IPv6Address: "fdcc:ad94:bacf:61a4::cafe:3"},
+}
+
+func TestCheckAddress(t *testing.T) {
+	tests := []struct {
+		name      string
+		address   string
+		cidr      string
+		selfID    string
+		addressOf func(client.Client) string
+		wantErr   string
+	}{
+		{"free IPv4", "10.8.0.10", "10.8.0.0/24", "", ipv4Of, ""},
+		{"outside CIDR", "10.9.0.10", "10.8.0.0/24", "", ipv4Of, "outside the interface CIDR"},
+		{"used by another peer", "10.8.0.3", "10.8.0.0/24", "abc-123", ipv4Of, "already assigned to client def-456 (phone)"},
+		{"keeps its own address", "10.8.0.2", "10.8.0.0/24", "abc-123", ipv4Of, ""},
+		{"unparseable address", "10.8.0", "10.8.0.0/24", "", ipv4Of, "not a valid IP address"},
+		{"CIDR given as address", "10.8.0.10/32", "10.8.0.0/24", "", ipv4Of, "not a valid IP address"},
+		{"unparseable interface CIDR", "10.8.0.10", "10.8.0.0/33", "", ipv4Of, "interface CIDR"},
+		{"free IPv6", "fdcc:ad94:bacf:61a4::cafe:10", "fdcc:ad94:bacf:61a4::cafe:0/112", "", ipv6Of, ""},
+		{"IPv6 outside CIDR", "fdcc:ad94:bacf:61a5::1", "fdcc:ad94:bacf:61a4::cafe:0/112", "", ipv6Of, "outside the interface CIDR"},
+		{"IPv6 used in another spelling", "fdcc:ad94:bacf:61a4:0:0:cafe:3", "fdcc:ad94:bacf:61a4::cafe:0/112", "", ipv6Of, "already assigned to client def-456"},
+		{"IPv6 keeps its own address", "fdcc:ad94:bacf:61a4::cafe:2", "fdcc:ad94:bacf:61a4::cafe:0/112", "abc-123", ipv6Of, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkAddress(tt.address, tt.cidr, tt.selfID, testPeers, tt.addressOf)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateAddresses(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    map[string]tftypes.Value
+		state     map[string]tftypes.Value // nil on create
+		wantPaths []string
+		wantCalls bool
+	}{
+		{
+			name:      "conflicting IPv4 on create",
+			config:    map[string]tftypes.Value{"name": tfString("tablet"), "ipv4_address": tfString("10.8.0.3")},
+			wantPaths: []string{"ipv4_address"},
+			wantCalls: true,
+		},
+		{
+			name:      "IPv6 outside the interface CIDR",
+			config:    map[string]tftypes.Value{"name": tfString("tablet"), "ipv6_address": tfString("fd00::1")},
+			wantPaths: []string{"ipv6_address"},
+			wantCalls: true,
+		},
+		{
+			name:      "free addresses",
+			config:    map[string]tftypes.Value{"name": tfString("tablet"), "ipv4_address": tfString("10.8.0.10"), "ipv6_address": tfString("fdcc:ad94:bacf:61a4::cafe:10")},
+			wantCalls: true,
+		},
+		{
+			name:      "resource moving onto its own current address",
+			config:    map[string]tftypes.Value{"name": tfString("laptop"), "ipv4_address": tfString("10.8.0.2")},
+			state:     map[string]tftypes.Value{"id": tfString("abc-123"), "name": tfString("laptop"), "ipv4_address": tfString("10.8.0.20")},
+			wantCalls: true,
+		},
+		{
+			name:   "unchanged address is not checked",
+			config: map[string]tftypes.Value{"name": tfString("renamed"), "ipv4_address": tfString("10.8.0.3")},
+			state:  map[string]tftypes.Value{"id": tfString("def-456"), "name": tfString("phone"), "ipv4_address": tfString("10.8.0.3")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			r := newTestResource(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/api/admin/interface":
+					json.NewEncoder(w).Encode(client.Interface{IPv4CIDR: "10.8.0.0/24", IPv6CIDR: "fdcc:ad94:bacf:61a4::cafe:0/112"})
+				case "/api/client":
+					json.NewEncoder(w).Encode(testPeers)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			s := testSchema(t)
+			config := objectValue(t, tt.config)
+			state := tftypes.NewValue(config.Type(), nil)
+			if tt.state != nil {
+				state = objectValue(t, tt.state)
+			}
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: s, Raw: config},
+				Plan:   tfsdk.Plan{Schema: s, Raw: config},
+				State:  tfsdk.State{Schema: s, Raw: state},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			r.validateAddresses(context.Background(), req, &resp)
+
+			var gotPaths []string
+			for _, d := range resp.Diagnostics.Errors() {
+				withPath, ok := d.(interface{ Path() path.Path })
+				if !ok {
+					t.Fatalf("unexpected error without attribute path: %s: %s", d.Summary(), d.Detail())
+				}
+				gotPaths = append(gotPaths, withPath.Path().String())
+			}
+			if strings.Join(gotPaths, ",") != strings.Join(tt.wantPaths, ",") {
+				t.Errorf("expected errors on %v, got %v (%v)", tt.wantPaths, gotPaths, resp.Diagnostics)
+			}
+			if (calls > 0) != tt.wantCalls {
+				t.Errorf("expected API calls: %v, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+)
+
+// rollbackTimeout bounds the cleanup of a half-created peer. Cleanup runs on a
+// context detached from the create deadline, which may be what failed.
+const rollbackTimeout = 30 * time.Second
+
+// clientCreator is the subset of the API client used to create a peer.
+type clientCreator interface {
+	CreateClient(ctx context.Context, req client.CreateClientRequest) (string, error)
+	GetClient(ctx context.Context, id string) (*client.Client, error)
+	UpdateClient(ctx context.Context, id string, req client.UpdateClientRequest) (*client.Client, error)
+	DeleteClient(ctx context.Context, id string) error
+}
+
+// createClient creates a peer and applies the remaining plan fields in a
+// follow-up update, since the create endpoint only accepts a name and expiry.
+// The sequence is atomic from Terraform's point of view: if any step after the
+// initial create fails, the new peer is deleted so that it is neither orphaned
+// nor duplicated by the next apply.
+func createClient(ctx context.Context, api clientCreator, plan clientResourceModel) (*client.Client, error) {
+	createReq := client.CreateClientRequest{
+		Name: plan.Name.ValueString(),
+	}
+	if isSetString(plan.ExpiresAt) {
+		v := plan.ExpiresAt.ValueString()
+		createReq.ExpiresAt = &v
+	}
+
+	clientID, err := api.CreateClient(ctx, createReq)
+	if err != nil {
+		return nil, fmt.Errorf("creating client: %w", err)
+	}
+
+	created, err := finishCreate(ctx, api, clientID, plan)
+	if err != nil {
+		return nil, rollbackCreate(ctx, api, clientID, err)
+	}
+	return created, nil
+}
+
+// finishCreate applies the plan to a freshly created peer and reads it back.
+func finishCreate(ctx context.Context, api clientCreator, clientID string, plan clientResourceModel) (*client.Client, error) {
+	if needsUpdate(plan) {
+		current, err := api.GetClient(ctx, clientID)
+		if err != nil {
+			return nil, fmt.Errorf("reading client %s after creation: %w", clientID, err)
+		}
+		updateReq := buildUpdateRequest(ctx, plan, current)
+		if _, err := api.UpdateClient(ctx, clientID, updateReq); err != nil {
+			return nil, fmt.Errorf("updating client %s after creation: %w", clientID, err)
+		}
+	}
+
+	readBack, err := api.GetClient(ctx, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("reading client %s after creation: %w", clientID, err)
+	}
+	return readBack, nil
+}
+
+// rollbackCreate deletes the half-created peer clientID and returns cause,
+// annotated with the outcome of the cleanup.
+func rollbackCreate(ctx context.Context, api clientCreator, clientID string, cause error) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
+	defer cancel()
+
+	err := api.DeleteClient(ctx, clientID)
+	var notFound *client.NotFoundError
+	if err != nil && !errors.As(err, &notFound) {
+		return fmt.Errorf("%w; additionally, removing the partially created client %s failed, delete it manually: %v", cause, clientID, err)
+	}
+	return fmt.Errorf("%w; the partially created client %s was removed", cause, clientID)
+}
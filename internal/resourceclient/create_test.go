@@ -0,0 +1,178 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fakeCreator is an in-memory clientCreator that can be told to fail individual calls.
+type fakeCreator struct {
+	clients map[string]*client.Client
+
+	createErr error
+	getErr    error
+	updateErr error
+	deleteErr error
+
+	deleted []string
+}
+
+func newFakeCreator() *fakeCreator {
+	return &fakeCreator{clients: map[string]*client.Client{}}
+}
+
+func (f *fakeCreator) CreateClient(_ context.Context, req client.CreateClientRequest) (string, error) {
+	if f.createErr != nil {
+		return "", f.createErr
+	}
+	id := "new-id"
+	f.clients[id] = &client.Client{ID: client.FlexibleID(id), Name: req.Name, Enabled: true}
+	return id, nil
+}
+
+func (f *fakeCreator) GetClient(_ context.Context, id string) (*client.Client, error) {
+	if f.getErr != nil {
+		return nil, f.getErr
+	}
+	c, ok := f.clients[id]
+	if !ok {
+		return nil, &client.NotFoundError{ID: id}
+	}
+	return c, nil
+}
+
+func (f *fakeCreator) UpdateClient(_ context.Context, id string, req client.UpdateClientRequest) (*client.Client, error) {
+	if f.updateErr != nil {
+		return nil, f.updateErr
+	}
+	c := f.clients[id]
+	c.MTU = req.MTU
+	return c, nil
+}
+
+func (f *fakeCreator) DeleteClient(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if f.deleteErr != nil {
+		return f.deleteErr
+	}
+	f.deleted = append(f.deleted, id)
+	delete(f.clients, id)
+	return nil
+}
+
+func planWithMTU(mtu int64) clientResourceModel {
+	return clientResourceModel{
+		Name: types.StringValue("laptop"),
+		MTU:  types.Int64Value(mtu),
+	}
+}
+
+func TestCreateClientSuccess(t *testing.T) {
+	api := newFakeCreator()
+
+	created, err := createClient(context.Background(), api, planWithMTU(1280))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created.ID != "new-id" || created.MTU != 1280 {
+		t.Errorf("unexpected client: %+v", created)
+	}
+	if len(api.deleted) != 0 {
+		t.Errorf("expected no rollback, deleted %v", api.deleted)
+	}
+}
+
+func TestCreateClientCreateFails(t *testing.T) {
+	api := newFakeCreator()
+	api.createErr = errors.New("boom")
+
+	if _, err := createClient(context.Background(), api, planWithMTU(1280)); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(api.deleted) != 0 {
+		t.Errorf("nothing was created, expected no rollback, deleted %v", api.deleted)
+	}
+}
+
+func TestCreateClientRollsBackOnUpdateFailure(t *testing.T) {
+	api := newFakeCreator()
+	api.updateErr = errors.New("unexpected status 500")
+
+	_, err := createClient(context.Background(), api, planWithMTU(1280))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !errors.Is(err, api.updateErr) {
+		t.Errorf("expected the update error to be wrapped, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "was removed") {
+		t.Errorf("expected error to report the rollback, got %v", err)
+	}
+	if len(api.deleted) != 1 || api.deleted[0] != "new-id" {
+		t.Errorf("expected orphan new-id to be deleted, deleted %v", api.deleted)
+	}
+	if len(api.clients) != 0 {
+		t.Errorf("expected no clients left, got %v", api.clients)
+	}
+}
+
+func TestCreateClientRollsBackOnReadFailure(t *testing.T) {
+	api := newFakeCreator()
+	api.getErr = errors.New("connection reset")
+
+	// No follow-up update is needed, so the read-back is the failing step.
+	plan := clientResourceModel{Name: types.StringValue("laptop")}
+	if _, err := createClient(context.Background(), api, plan); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(api.deleted) != 1 {
+		t.Errorf("expected orphan to be deleted, deleted %v", api.deleted)
+	}
+}
+
+func TestCreateClientRollbackSurvivesCancelledContext(t *testing.T) {
+	api := newFakeCreator()
+	ctx, cancel := context.WithCancel(context.Background())
+	api.updateErr = context.Canceled
+	cancel()
+
+	if _, err := createClient(ctx, api, planWithMTU(1280)); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(api.deleted) != 1 {
+		t.Errorf("expected rollback to run despite the cancelled context, deleted %v", api.deleted)
+	}
+}
+
+func TestCreateClientRollbackFails(t *testing.T) {
+	api := newFakeCreator()
+	api.updateErr = errors.New("unexpected status 500")
+	api.deleteErr = errors.New("unexpected status 503")
+
+	_, err := createClient(context.Background(), api, planWithMTU(1280))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "new-id") || !strings.Contains(err.Error(), "delete it manually") {
+		t.Errorf("expected error to name the orphaned client, got %v", err)
+	}
+}
+
+func TestCreateClientRollbackIgnoresNotFound(t *testing.T) {
+	api := newFakeCreator()
+	api.updateErr = errors.New("unexpected status 500")
+	api.deleteErr = &client.NotFoundError{ID: "new-id"}
+
+	_, err := createClient(context.Background(), api, planWithMTU(1280))
+	if err == nil || !strings.Contains(err.Error(), "was removed") {
+		t.Errorf("a peer that is already gone counts as rolled back, got %v", err)
+	}
+}
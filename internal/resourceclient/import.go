@@ -0,0 +1,85 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Import ID prefixes that look a client up instead of naming its ID directly.
+const (
+	importPrefixName   = "name:"
+	importPrefixPubkey = "pubkey:"
+)
+
+func (r *clientResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the client.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// ImportState accepts a client ID, "name:<name>" or "pubkey:<public key>",
+// or an identity block with the client ID.
+func (r *clientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !strings.HasPrefix(req.ID, importPrefixName) && !strings.HasPrefix(req.ID, importPrefixPubkey) {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	clients, err := r.apiClient.GetClients(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading clients", err.Error())
+		return
+	}
+	id, err := resolveImportID(clients, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Cannot import client", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	setIdentity(ctx, resp.Identity, id, &resp.Diagnostics)
+}
+
+// resolveImportID maps a "name:" or "pubkey:" import ID to the ID of the one
+// client it matches.
+func resolveImportID(clients []client.Client, importID string) (string, error) {
+	var name, publicKey string
+	attr := "name"
+	if strings.HasPrefix(importID, importPrefixPubkey) {
+		attr, publicKey = "public key", strings.TrimPrefix(importID, importPrefixPubkey)
+	} else {
+		name = strings.TrimPrefix(importID, importPrefixName)
+	}
+	if name == "" && publicKey == "" {
+		return "", fmt.Errorf("import ID %q is missing the %s to look up", importID, attr)
+	}
+
+	c, err := client.FindClient(clients, "", name, publicKey)
+	if err != nil {
+		return "", err
+	}
+	return c.ID.String(), nil
+}
+
+// setIdentity records the client ID as the resource identity. Identity is nil
+// when Terraform predates resource identity (before 1.12).
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id string, diags *diag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+	diags.Append(identity.SetAttribute(ctx, path.Root("id"), id)...)
+}
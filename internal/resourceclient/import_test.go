@@ -0,0 +1,47 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+)
+
+var importTestClients = []client.Client{
+	{ID: "1", Name: "alice-laptop", PublicKey: "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="},
+	{ID: "2", Name: "phone", PublicKey: "HIgo9xNzJMWLKASShiTqIybxZ0U3wGLiUeJ1PKf8ykw="},
+	{ID: "3", Name: "phone", PublicKey: "TrMvSoP4jYQlY6RIzBgbssQqY3vxI2Pi+y71lOWWXX0="},
+}
+
+func TestResolveImportID(t *testing.T) {
+	tests := map[string]string{
+		"name:alice-laptop": "1",
+		"pubkey:TrMvSoP4jYQlY6RIzBgbssQqY3vxI2Pi+y71lOWWXX0=": "3",
+	}
+	for importID, want := range tests {
+		got, err := resolveImportID(importTestClients, importID)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", importID, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("%s: expected ID %s, got %s", importID, want, got)
+		}
+	}
+}
+
+func TestResolveImportIDErrors(t *testing.T) {
+	tests := map[string]string{
+		"name:tablet":        `no client has name "tablet"`,
+		"name:phone":         "IDs 2, 3",
+		"pubkey:unknown-key": `no client has public key "unknown-key"`,
+		"name:":              "missing the name",
+	}
+	for importID, want := range tests {
+		_, err := resolveImportID(importTestClients, importID)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: expected error containing %q, got %v", importID, want, err)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.ListTypable                    = ipListType{}
+	_ basetypes.ListValuableWithSemanticEquals = ipListValue{}
+)
+
+// ipListType is a list of IP addresses and CIDRs whose values are compared
+// semantically: order is ignored, a bare address equals its host prefix
+// (10.0.0.1 and 10.0.0.1/32), and IPv6 spellings are canonicalized. Values the
+// server echoes back in another form therefore do not show up as drift.
+type ipListType struct {
+	basetypes.ListType
+}
+
+// newIPListType returns the list type used for allowed_ips, server_allowed_ips and dns.
+func newIPListType() ipListType {
+	return ipListType{ListType: basetypes.ListType{ElemType: types.StringType}}
+}
+
+func (t ipListType) Equal(o attr.Type) bool {
+	other, ok := o.(ipListType)
+	if !ok {
+		return false
+	}
+	return t.ListType.Equal(other.ListType)
+}
+
+func (t ipListType) String() string {
+	return "ipListType"
+}
+
+func (t ipListType) ValueFromList(_ context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return ipListValue{ListValue: in}, nil
+}
+
+func (t ipListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ListType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	listValue, ok := attrValue.(basetypes.ListValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return ipListValue{ListValue: listValue}, nil
+}
+
+func (t ipListType) ValueType(_ context.Context) attr.Value {
+	return ipListValue{}
+}
+
+// ipListValue is a value of ipListType.
+type ipListValue struct {
+	basetypes.ListValue
+}
+
+// newIPListValue converts a string slice to an ipListValue; nil becomes an empty list.
+func newIPListValue(ctx context.Context, values []string, diags *diag.Diagnostics) ipListValue {
+	return ipListValue{ListValue: sliceToList(ctx, values, diags)}
+}
+
+func (v ipListValue) Type(_ context.Context) attr.Type {
+	return newIPListType()
+}
+
+func (v ipListValue) Equal(o attr.Value) bool {
+	other, ok := o.(ipListValue)
+	if !ok {
+		return false
+	}
+	return v.ListValue.Equal(other.ListValue)
+}
+
+func (v ipListValue) ListSemanticEquals(ctx context.Context, priorValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	prior, ok := priorValuable.(ipListValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got %T. Please report this to the provider developers.", v, priorValuable),
+		)
+		return false, diags
+	}
+
+	var current, previous []string
+	diags.Append(v.ElementsAs(ctx, &current, false)...)
+	diags.Append(prior.ElementsAs(ctx, &previous, false)...)
+	if diags.HasError() {
+		return false, diags
+	}
+	return equalIPLists(current, previous), diags
+}
+
+// equalIPLists reports whether a and b hold the same addresses and prefixes,
+// in any order.
+func equalIPLists(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	na, nb := normalizeIPList(a), normalizeIPList(b)
+	return slices.Equal(na, nb)
+}
+
+// normalizeIPList returns the canonical, sorted form of values. Bare addresses
+// become host prefixes; anything unparseable is kept verbatim.
+func normalizeIPList(values []string) []string {
+	out := make([]string, len(values))
+	for i, value := range values {
+		out[i] = normalizeIP(value)
+	}
+	slices.Sort(out)
+	return out
+}
+
+func normalizeIP(value string) string {
+	if addr, err := netip.ParseAddr(value); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()).String()
+	}
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		return prefix.String()
+	}
+	return value
+}
@@ -0,0 +1,64 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEqualIPLists(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  []string
+		equal bool
+	}{
+		{"identical", []string{"10.8.0.0/24"}, []string{"10.8.0.0/24"}, true},
+		{"reordered", []string{"10.8.0.0/24", "0.0.0.0/0"}, []string{"0.0.0.0/0", "10.8.0.0/24"}, true},
+		{"bare ipv4 host", []string{"10.0.0.1"}, []string{"10.0.0.1/32"}, true},
+		{"bare ipv6 host", []string{"fd00::1"}, []string{"fd00::1/128"}, true},
+		{"ipv6 compression", []string{"fd00:0:0:0:0:0:0:1"}, []string{"fd00::1"}, true},
+		{"ipv6 prefix compression", []string{"2001:0db8:0000::/48"}, []string{"2001:db8::/48"}, true},
+		{"both empty", []string{}, nil, true},
+		{"different prefix length", []string{"10.0.0.1/24"}, []string{"10.0.0.1/32"}, false},
+		{"different address", []string{"1.1.1.1"}, []string{"1.0.0.1"}, false},
+		{"different length", []string{"1.1.1.1"}, []string{"1.1.1.1", "1.0.0.1"}, false},
+		{"duplicates count", []string{"1.1.1.1", "1.1.1.1"}, []string{"1.1.1.1", "1.0.0.1"}, false},
+		{"unparseable compared verbatim", []string{"dns.example"}, []string{"dns.example"}, true},
+	}
+	for _, tt := range tests {
+		if got := equalIPLists(tt.a, tt.b); got != tt.equal {
+			t.Errorf("%s: equalIPLists(%v, %v) = %v, want %v", tt.name, tt.a, tt.b, got, tt.equal)
+		}
+	}
+}
+
+func TestIPListSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	config := newIPListValue(ctx, []string{"10.0.0.1", "fd00:0:0:0:0:0:0:1"}, &diags)
+	server := newIPListValue(ctx, []string{"fd00::1/128", "10.0.0.1/32"}, &diags)
+	other := newIPListValue(ctx, []string{"10.0.0.2/32", "fd00::1/128"}, &diags)
+	if diags.HasError() {
+		t.Fatalf("building lists: %v", diags)
+	}
+
+	equal, d := server.ListSemanticEquals(ctx, config)
+	if d.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", d)
+	}
+	if !equal {
+		t.Error("expected the server's spelling to equal the configured one")
+	}
+
+	equal, _ = other.ListSemanticEquals(ctx, config)
+	if equal {
+		t.Error("expected different addresses not to be equal")
+	}
+
+	if _, d := server.ListSemanticEquals(ctx, types.ListNull(types.StringType)); !d.HasError() {
+		t.Error("expected an error when comparing against a plain list")
+	}
+}
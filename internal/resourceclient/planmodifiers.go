@@ -0,0 +1,39 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nullPrivateKeyWithPublicKey plans private_key as null when public_key is set
+// in the configuration: the device owns its keypair and the private key must
+// never be stored in state.
+type nullPrivateKeyWithPublicKey struct{}
+
+func (m nullPrivateKeyWithPublicKey) Description(_ context.Context) string {
+	return "Not stored when public_key is configured."
+}
+
+func (m nullPrivateKeyWithPublicKey) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m nullPrivateKeyWithPublicKey) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var publicKey types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("public_key"), &publicKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if publicKey.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+	if !publicKey.IsNull() {
+		resp.PlanValue = types.StringNull()
+	}
+}
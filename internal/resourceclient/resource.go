@@ -4,11 +4,14 @@ package resourceclient
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -17,12 +20,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// defaultTimeout bounds each operation, including all of its API calls,
+// when the timeouts block does not set one.
+const defaultTimeout = 5 * time.Minute
+
 var (
-	_ resource.Resource                = &clientResource{}
-	_ resource.ResourceWithImportState = &clientResource{}
+	_ resource.Resource                     = &clientResource{}
+	_ resource.ResourceWithImportState      = &clientResource{}
+	_ resource.ResourceWithModifyPlan       = &clientResource{}
+	_ resource.ResourceWithIdentity         = &clientResource{}
+	_ resource.ResourceWithConfigValidators = &clientResource{}
 )
 
 type clientResource struct {
@@ -38,7 +49,7 @@ func (r *clientResource) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_client"
 }
 
-func (r *clientResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *clientResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a WireGuard client/peer on a wg-easy instance.",
 		Attributes: map[string]schema.Attribute{
@@ -60,36 +71,44 @@ func (r *clientResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Default:     booldefault.StaticBool(true),
 			},
 			"ipv4_address": schema.StringAttribute{
-				Description: "The IPv4 address assigned to the client.",
-				Computed:    true,
+				Description: "The IPv4 address of the client. Must lie inside the interface IPv4 CIDR and be free; " +
+					"assigned by wg-easy if not set.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"ipv6_address": schema.StringAttribute{
-				Description: "The IPv6 address assigned to the client.",
-				Computed:    true,
+				Description: "The IPv6 address of the client. Must lie inside the interface IPv6 CIDR and be free; " +
+					"assigned by wg-easy if not set.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"public_key": schema.StringAttribute{
-				Description: "The public key of the client.",
-				Computed:    true,
+				Description: "The public key of the client. Set it to enroll a device that generated its own keypair; " +
+					"otherwise wg-easy generates the keypair.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"private_key": schema.StringAttribute{
-				Description: "The private key of the client.",
+				Description: "The private key of the client. Null when public_key is set, as the private key then never leaves the device.",
 				Computed:    true,
 				Sensitive:   true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					nullPrivateKeyWithPublicKey{},
 				},
 			},
 			"preshared_key": schema.StringAttribute{
-				Description: "The preshared key of the client.",
+				Description: "The preshared key of the client. Generated by wg-easy if not set.",
+				Optional:    true,
 				Computed:    true,
 				Sensitive:   true,
 				PlanModifiers: []planmodifier.String{
@@ -97,49 +116,73 @@ func (r *clientResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"expires_at": schema.StringAttribute{
-				Description: "The expiration date of the client (ISO 8601 format).",
+				Description: "The expiration date of the client (RFC 3339, e.g. 2026-12-31T00:00:00Z).",
 				Optional:    true,
+				Validators: []validator.String{
+					isRFC3339(),
+				},
 			},
 			"allowed_ips": schema.ListAttribute{
 				Description: "List of allowed IPs for the client. Empty list means use server default.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
+				CustomType:  newIPListType(),
 				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(isCIDROrIP()),
+				},
 			},
 			"server_allowed_ips": schema.ListAttribute{
 				Description: "List of server-side allowed IPs. Empty list means use server default.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
+				CustomType:  newIPListType(),
 				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(isCIDROrIP()),
+				},
 			},
 			"dns": schema.ListAttribute{
 				Description: "List of DNS servers for the client. Empty list means use server default.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
+				CustomType:  newIPListType(),
 				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(isIP()),
+				},
 			},
 			"mtu": schema.Int64Attribute{
-				Description: "MTU value for the client (minimum 1024).",
+				Description: "MTU value for the client (1024-9000).",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.Between(1024, 9000),
+				},
 			},
 			"persistent_keepalive": schema.Int64Attribute{
-				Description: "Persistent keepalive interval in seconds.",
+				Description: "Persistent keepalive interval in seconds (0-65535, 0 disables it).",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
 			},
 			"server_endpoint": schema.StringAttribute{
-				Description: "The server endpoint for the client.",
+				Description: "The server endpoint for the client, as host:port.",
 				Optional:    true,
+				Validators: []validator.String{
+					isHostPort(),
+				},
 			},
 			"pre_up": schema.StringAttribute{
 				Description: "Command to run before bringing up the interface.",
@@ -166,28 +209,53 @@ func (r *clientResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Default:     stringdefault.StaticString(""),
 			},
 			"jc": schema.Int64Attribute{
-				Description: "Jitter coefficient (jC) for WireGuard.",
+				Description: "Jitter coefficient (jC) for WireGuard (0-128).",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 128),
+				},
 			},
 			"j_min": schema.Int64Attribute{
-				Description: "Minimum jitter value (jMin) for WireGuard.",
+				Description: "Minimum jitter value (jMin) for WireGuard (0-1280, at most j_max).",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 1280),
+				},
 			},
 			"j_max": schema.Int64Attribute{
-				Description: "Maximum jitter value (jMax) for WireGuard.",
+				Description: "Maximum jitter value (jMax) for WireGuard (0-1280).",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 1280),
+				},
+			},
+			"rotate_keys_trigger": schema.StringAttribute{
+				Description: "Arbitrary value; changing it regenerates the client keys in place, keeping its ID and addresses.",
+				Optional:    true,
+			},
+			"key_rotation_interval": schema.StringAttribute{
+				Description: "Maximum age of the client keys as a Go duration (e.g. 720h). " +
+					"Once elapsed, the next apply regenerates the keys in place.",
+				Optional: true,
+				Validators: []validator.String{
+					isPositiveDuration(),
+				},
+			},
+			"keys_rotated_at": schema.StringAttribute{
+				Description: "When the client keys were last generated by Terraform (RFC 3339).",
+				Computed:    true,
 			},
 			"created_at": schema.StringAttribute{
 				Description: "The creation timestamp.",
@@ -201,6 +269,20 @@ func (r *clientResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *clientResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		jitterRange{},
 	}
 }
 
@@ -226,40 +308,17 @@ func (r *clientResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Step 1: Create the client (only name + expiresAt).
-	createReq := client.CreateClientRequest{
-		Name: plan.Name.ValueString(),
-	}
-	if !plan.ExpiresAt.IsNull() && !plan.ExpiresAt.IsUnknown() {
-		v := plan.ExpiresAt.ValueString()
-		createReq.ExpiresAt = &v
-	}
-
-	clientID, err := r.apiClient.CreateClient(createReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating client", err.Error())
+	timeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	// Step 2: If there are additional fields to set, fetch and update.
-	if needsUpdate(plan) {
-		current, err := r.apiClient.GetClient(clientID)
-		if err != nil {
-			resp.Diagnostics.AddError("Error reading client after creation", err.Error())
-			return
-		}
-		updateReq := buildUpdateRequest(ctx, plan, current)
-		_, err = r.apiClient.UpdateClient(clientID, updateReq)
-		if err != nil {
-			resp.Diagnostics.AddError("Error updating client after creation", err.Error())
-			return
-		}
-	}
-
-	// Step 3: Read back to get server-authoritative values.
-	readBack, err := r.apiClient.GetClient(clientID)
+	readBack, err := createClient(ctx, r.apiClient, plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading client after creation", err.Error())
+		resp.Diagnostics.AddError("Error creating client", err.Error())
 		return
 	}
 
@@ -267,8 +326,10 @@ func (r *clientResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.KeysRotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	setIdentity(ctx, resp.Identity, plan.ID.ValueString(), &resp.Diagnostics)
 }
 
 func (r *clientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -278,7 +339,15 @@ func (r *clientResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	apiClient, err := r.apiClient.GetClient(state.ID.ValueString())
+	timeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	apiClient, err := r.apiClient.GetClient(ctx, state.ID.ValueString())
 	if err != nil {
 		if _, ok := err.(*client.NotFoundError); ok {
 			resp.State.RemoveResource(ctx)
@@ -294,6 +363,7 @@ func (r *clientResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	setIdentity(ctx, resp.Identity, state.ID.ValueString(), &resp.Diagnostics)
 }
 
 func (r *clientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -303,28 +373,54 @@ func (r *clientResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	var state clientResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	timeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	// Fetch current client state to merge with planned changes.
-	current, err := r.apiClient.GetClient(state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error reading client before update", err.Error())
+	var state clientResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateReq := buildUpdateRequest(ctx, plan, current)
+	// ModifyPlan leaves keys_rotated_at unknown only when a rotation is due.
+	rotate := plan.KeysRotatedAt.IsUnknown()
 
-	_, err = r.apiClient.UpdateClient(state.ID.ValueString(), updateReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating client", err.Error())
-		return
+	if onlyEnabledChanged(plan, state) {
+		// Use the dedicated endpoints so concurrent edits made in the UI are not
+		// overwritten by a full update built from a stale snapshot.
+		if err := setEnabled(ctx, r.apiClient, state.ID.ValueString(), plan.Enabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Error updating client", err.Error())
+			return
+		}
+	} else {
+		// Fetch current client state to merge with planned changes.
+		current, err := r.apiClient.GetClient(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading client before update", err.Error())
+			return
+		}
+
+		updateReq := buildUpdateRequest(ctx, plan, current)
+		if rotate {
+			if err := rotateKeys(plan, &updateReq); err != nil {
+				resp.Diagnostics.AddError("Error rotating client keys", err.Error())
+				return
+			}
+		}
+
+		_, err = r.apiClient.UpdateClient(ctx, state.ID.ValueString(), updateReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating client", err.Error())
+			return
+		}
 	}
 
-	readBack, err := r.apiClient.GetClient(state.ID.ValueString())
+	readBack, err := r.apiClient.GetClient(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading client after update", err.Error())
 		return
@@ -334,8 +430,12 @@ func (r *clientResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if rotate {
+		plan.KeysRotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	setIdentity(ctx, resp.Identity, plan.ID.ValueString(), &resp.Diagnostics)
 }
 
 func (r *clientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -345,7 +445,15 @@ func (r *clientResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.apiClient.DeleteClient(state.ID.ValueString())
+	timeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := r.apiClient.DeleteClient(ctx, state.ID.ValueString())
 	if err != nil {
 		if _, ok := err.(*client.NotFoundError); !ok {
 			resp.Diagnostics.AddError("Error deleting client", err.Error())
@@ -353,18 +461,78 @@ func (r *clientResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
-func (r *clientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (r *clientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.validateAddresses(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planKeyRotation(ctx, req, resp)
+}
+
+// onlyEnabledChanged returns true if enabled is the only attribute the update
+// has to push to the server.
+func onlyEnabledChanged(plan, state clientResourceModel) bool {
+	if plan.Enabled.Equal(state.Enabled) {
+		return false
+	}
+	pairs := [][2]attr.Value{
+		{plan.Name, state.Name},
+		{plan.IPv4Address, state.IPv4Address},
+		{plan.IPv6Address, state.IPv6Address},
+		{plan.PublicKey, state.PublicKey},
+		{plan.PresharedKey, state.PresharedKey},
+		{plan.KeysRotatedAt, state.KeysRotatedAt},
+		{plan.ExpiresAt, state.ExpiresAt},
+		{plan.AllowedIPs, state.AllowedIPs},
+		{plan.ServerAllowedIPs, state.ServerAllowedIPs},
+		{plan.DNS, state.DNS},
+		{plan.MTU, state.MTU},
+		{plan.PersistentKeepalive, state.PersistentKeepalive},
+		{plan.ServerEndpoint, state.ServerEndpoint},
+		{plan.PreUp, state.PreUp},
+		{plan.PostUp, state.PostUp},
+		{plan.PreDown, state.PreDown},
+		{plan.PostDown, state.PostDown},
+		{plan.JC, state.JC},
+		{plan.JMin, state.JMin},
+		{plan.JMax, state.JMax},
+	}
+	for _, p := range pairs {
+		if !p[0].Equal(p[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// setEnabled enables or disables a client through the dedicated endpoints.
+func setEnabled(ctx context.Context, apiClient *client.WGEasyClient, id string, enabled bool) error {
+	if enabled {
+		return apiClient.EnableClient(ctx, id)
+	}
+	return apiClient.DisableClient(ctx, id)
 }
 
 // needsUpdate returns true if the plan has optional fields that need a follow-up update call.
 func needsUpdate(plan clientResourceModel) bool {
-	if hasNonEmptyList(plan.AllowedIPs) || hasNonEmptyList(plan.ServerAllowedIPs) || hasNonEmptyList(plan.DNS) {
+	if hasNonEmptyList(plan.AllowedIPs.ListValue) || hasNonEmptyList(plan.ServerAllowedIPs.ListValue) || hasNonEmptyList(plan.DNS.ListValue) {
 		return true
 	}
 	if isSetInt64(plan.MTU) || isSetInt64(plan.PersistentKeepalive) || isSetString(plan.ServerEndpoint) {
 		return true
 	}
+	if isSetString(plan.PublicKey) || isSetString(plan.PresharedKey) {
+		return true
+	}
+	if isSetString(plan.IPv4Address) || isSetString(plan.IPv6Address) {
+		return true
+	}
 	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() && !plan.Enabled.ValueBool() {
 		return true
 	}
@@ -387,7 +555,7 @@ func isSetString(val types.String) bool {
 // then overlays any values from the plan.
 func buildUpdateRequest(ctx context.Context, plan clientResourceModel, current *client.Client) client.UpdateClientRequest {
 	req := initUpdateRequestFromCurrent(current)
-	applyPlanToUpdateRequest(ctx, plan, &req)
+	applyPlanToUpdateRequest(ctx, plan, current, &req)
 	return req
 }
 
@@ -419,18 +587,20 @@ func initUpdateRequestFromCurrent(current *client.Client) client.UpdateClientReq
 	}
 }
 
-func applyPlanToUpdateRequest(ctx context.Context, plan clientResourceModel, req *client.UpdateClientRequest) {
+func applyPlanToUpdateRequest(ctx context.Context, plan clientResourceModel, current *client.Client, req *client.UpdateClientRequest) {
 	req.Name = plan.Name.ValueString()
 	req.Enabled = plan.Enabled.ValueBool()
+	applyStringField(plan.IPv4Address, &req.IPv4Address)
+	applyStringField(plan.IPv6Address, &req.IPv6Address)
 
 	if isSetString(plan.ExpiresAt) {
 		v := plan.ExpiresAt.ValueString()
 		req.ExpiresAt = &v
 	}
 
-	applyListField(ctx, plan.AllowedIPs, &req.AllowedIPs)
-	applyListField(ctx, plan.ServerAllowedIPs, &req.ServerAllowedIPs)
-	applyDNSField(ctx, plan.DNS, &req.DNS)
+	applyListField(ctx, plan.AllowedIPs.ListValue, &req.AllowedIPs)
+	applyListField(ctx, plan.ServerAllowedIPs.ListValue, &req.ServerAllowedIPs)
+	applyDNSField(ctx, plan.DNS.ListValue, &req.DNS)
 
 	applyInt64Field(plan.MTU, &req.MTU)
 	applyInt64Field(plan.PersistentKeepalive, &req.PersistentKeepalive)
@@ -442,6 +612,11 @@ func applyPlanToUpdateRequest(ctx context.Context, plan clientResourceModel, req
 	applyInt64Field(plan.JC, &req.JC)
 	applyInt64Field(plan.JMin, &req.JMin)
 	applyInt64Field(plan.JMax, &req.JMax)
+
+	// Keys are only sent when they differ from the server, so that a plain
+	// update never touches the keypair.
+	applyKeyField(plan.PublicKey, current.PublicKey, &req.PublicKey)
+	applyKeyField(plan.PresharedKey, current.PresharedKey, &req.PresharedKey)
 }
 
 func applyListField(ctx context.Context, list types.List, target *[]string) {
@@ -486,14 +661,25 @@ func applyStringPtrField(val types.String, target **string) {
 	}
 }
 
+func applyKeyField(val types.String, current string, target **string) {
+	if isSetString(val) && val.ValueString() != current {
+		v := val.ValueString()
+		*target = &v
+	}
+}
+
 func mapClientToState(ctx context.Context, apiClient *client.Client, state *clientResourceModel, diags *diag.Diagnostics) {
 	state.ID = types.StringValue(apiClient.ID.String())
 	state.Name = types.StringValue(apiClient.Name)
 	state.Enabled = types.BoolValue(apiClient.Enabled)
 	state.IPv4Address = types.StringValue(apiClient.IPv4Address)
 	state.IPv6Address = types.StringValue(apiClient.IPv6Address)
+	// A null private key next to a known public key marks a user-supplied keypair:
+	// the server-side private key is meaningless then and must stay out of state.
+	if state.PublicKey.IsNull() || !state.PrivateKey.IsNull() {
+		state.PrivateKey = types.StringValue(apiClient.PrivateKey)
+	}
 	state.PublicKey = types.StringValue(apiClient.PublicKey)
-	state.PrivateKey = types.StringValue(apiClient.PrivateKey)
 	state.PresharedKey = types.StringValue(apiClient.PresharedKey)
 	state.CreatedAt = types.StringValue(apiClient.CreatedAt)
 	state.UpdatedAt = types.StringValue(apiClient.UpdatedAt)
@@ -519,9 +705,9 @@ func mapClientToState(ctx context.Context, apiClient *client.Client, state *clie
 		state.ServerEndpoint = types.StringNull()
 	}
 
-	state.AllowedIPs = sliceToList(ctx, apiClient.AllowedIPs, diags)
-	state.ServerAllowedIPs = sliceToList(ctx, apiClient.ServerAllowedIPs, diags)
-	state.DNS = sliceToList(ctx, apiClient.DNS, diags)
+	state.AllowedIPs = newIPListValue(ctx, apiClient.AllowedIPs, diags)
+	state.ServerAllowedIPs = newIPListValue(ctx, apiClient.ServerAllowedIPs, diags)
+	state.DNS = newIPListValue(ctx, apiClient.DNS, diags)
 }
 
 func sliceToList(ctx context.Context, slice []string, diags *diag.Diagnostics) types.List {
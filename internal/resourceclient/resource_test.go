@@ -0,0 +1,272 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newTestResource returns a wgeasy_client resource talking to a fake wg-easy
+// server. Logins are handled; every other request goes to handler.
+func newTestResource(t *testing.T, handler http.HandlerFunc) *clientResource {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/session" {
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewWGEasyClient(server.URL, "admin", "secret")
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	return &clientResource{apiClient: apiClient}
+}
+
+func testSchema(t *testing.T) schema.Schema {
+	t.Helper()
+	var resp resource.SchemaResponse
+	(&clientResource{}).Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+// objectValue builds a raw wgeasy_client value with the given attributes set
+// and every other attribute null.
+func objectValue(t *testing.T, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+	objType := testSchema(t).Type().TerraformType(context.Background()).(tftypes.Object)
+	for name := range values {
+		if _, ok := objType.AttributeTypes[name]; !ok {
+			t.Fatalf("unknown attribute %q", name)
+		}
+	}
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		} else {
+			attrs[name] = tftypes.NewValue(typ, nil)
+		}
+	}
+	return tftypes.NewValue(objType, attrs)
+}
+
+func tfString(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+
+// fakeAPI is an in-memory wg-easy client store that records the writes it receives.
+type fakeAPI struct {
+	mu      sync.Mutex
+	clients map[string]*client.Client
+	writes  []string
+}
+
+func (f *fakeAPI) handler() http.HandlerFunc {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/client", func(w http.ResponseWriter, _ *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		list := []client.Client{}
+		for _, c := range f.clients {
+			list = append(list, *c)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(list)
+	})
+	mux.HandleFunc("GET /api/client/{id}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		c, ok := f.clients[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(c)
+	})
+	mux.HandleFunc("POST /api/client/{id}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		c, ok := f.clients[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var req client.UpdateClientRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		c.Name = req.Name
+		c.Enabled = req.Enabled
+		f.writes = append(f.writes, "update")
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("POST /api/client/{id}/{action}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		c, ok := f.clients[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		action := r.PathValue("action")
+		c.Enabled = action == "enable"
+		f.writes = append(f.writes, action)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return mux.ServeHTTP
+}
+
+// readState runs Read for the client with the given ID, as after an import.
+func readState(t *testing.T, r *clientResource, id string) tfsdk.State {
+	t.Helper()
+	s := testSchema(t)
+	req := resource.ReadRequest{State: tfsdk.State{Schema: s, Raw: objectValue(t, map[string]tftypes.Value{"id": tfString(id)})}}
+	resp := resource.ReadResponse{State: req.State}
+	r.Read(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", resp.Diagnostics)
+	}
+	return resp.State
+}
+
+// update runs Update with a plan derived from state by setting the given attributes.
+func update(t *testing.T, r *clientResource, state tfsdk.State, changes map[string]interface{}) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw.Copy()}
+	for name, value := range changes {
+		if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+	req := resource.UpdateRequest{Plan: plan, State: state}
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()}}
+	r.Update(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("update: %v", resp.Diagnostics)
+	}
+	return resp.State
+}
+
+func stateEnabled(t *testing.T, state tfsdk.State) bool {
+	t.Helper()
+	var enabled types.Bool
+	if diags := state.GetAttribute(context.Background(), path.Root("enabled"), &enabled); diags.HasError() {
+		t.Fatalf("reading enabled: %v", diags)
+	}
+	return enabled.ValueBool()
+}
+
+func TestUpdateTogglesEnabledWithDedicatedEndpoints(t *testing.T) {
+	api := &fakeAPI{clients: map[string]*client.Client{"1": {ID: "1", Name: "laptop", Enabled: false}}}
+	r := newTestResource(t, api.handler())
+
+	state := readState(t, r, "1")
+	for _, enabled := range []bool{true, false, true} {
+		state = update(t, r, state, map[string]interface{}{"enabled": enabled})
+		if got := stateEnabled(t, state); got != enabled {
+			t.Errorf("expected enabled=%v in state, got %v", enabled, got)
+		}
+		if api.clients["1"].Enabled != enabled {
+			t.Errorf("expected enabled=%v on the server, got %v", enabled, api.clients["1"].Enabled)
+		}
+	}
+	if want := []string{"enable", "disable", "enable"}; !slices.Equal(api.writes, want) {
+		t.Errorf("expected writes %v, got %v", want, api.writes)
+	}
+}
+
+func TestUpdateEnabledWithOtherChangesUsesFullUpdate(t *testing.T) {
+	api := &fakeAPI{clients: map[string]*client.Client{"1": {ID: "1", Name: "laptop", Enabled: true}}}
+	r := newTestResource(t, api.handler())
+
+	state := readState(t, r, "1")
+	state = update(t, r, state, map[string]interface{}{"enabled": false, "name": "old-laptop"})
+
+	if want := []string{"update"}; !slices.Equal(api.writes, want) {
+		t.Errorf("expected writes %v, got %v", want, api.writes)
+	}
+	if c := api.clients["1"]; c.Enabled || c.Name != "old-laptop" {
+		t.Errorf("expected the full update to apply both changes, got %+v", c)
+	}
+	if stateEnabled(t, state) {
+		t.Error("expected enabled=false in state")
+	}
+}
+
+func TestReadRemovesClientDeletedOutOfBand(t *testing.T) {
+	api := &fakeAPI{clients: map[string]*client.Client{"1": {ID: "1", Name: "laptop", Enabled: true}}}
+	r := newTestResource(t, api.handler())
+
+	state := readState(t, r, "1")
+	delete(api.clients, "1")
+
+	req := resource.ReadRequest{State: state}
+	resp := resource.ReadResponse{State: state}
+	r.Read(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected the deleted client to be removed from state")
+	}
+}
+
+func TestOnlyEnabledChanged(t *testing.T) {
+	base := clientResourceModel{
+		ID:      types.StringValue("1"),
+		Name:    types.StringValue("laptop"),
+		Enabled: types.BoolValue(true),
+		MTU:     types.Int64Value(1420),
+		// Real plans always carry typed lists; zero-value lists never compare equal.
+		AllowedIPs:       ipListValue{ListValue: types.ListNull(types.StringType)},
+		ServerAllowedIPs: ipListValue{ListValue: types.ListNull(types.StringType)},
+		DNS:              ipListValue{ListValue: types.ListNull(types.StringType)},
+	}
+	tests := []struct {
+		name   string
+		change func(*clientResourceModel)
+		want   bool
+	}{
+		{"nothing", func(*clientResourceModel) {}, false},
+		{"enabled", func(m *clientResourceModel) { m.Enabled = types.BoolValue(false) }, true},
+		{"enabled and name", func(m *clientResourceModel) {
+			m.Enabled = types.BoolValue(false)
+			m.Name = types.StringValue("renamed")
+		}, false},
+		{"enabled and mtu", func(m *clientResourceModel) {
+			m.Enabled = types.BoolValue(false)
+			m.MTU = types.Int64Value(1280)
+		}, false},
+		{"enabled and a due key rotation", func(m *clientResourceModel) {
+			m.Enabled = types.BoolValue(false)
+			m.KeysRotatedAt = types.StringUnknown()
+		}, false},
+		{"name only", func(m *clientResourceModel) { m.Name = types.StringValue("renamed") }, false},
+	}
+	for _, tt := range tests {
+		plan := base
+		tt.change(&plan)
+		if got := onlyEnabledChanged(plan, base); got != tt.want {
+			t.Errorf("%s: onlyEnabledChanged() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
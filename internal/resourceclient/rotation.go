@@ -0,0 +1,119 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// planKeyRotation schedules an in-place key rotation when rotate_keys_trigger changed
+// or key_rotation_interval elapsed. A due rotation is marked by planning
+// keys_rotated_at and every Terraform-owned key as unknown.
+func planKeyRotation(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to rotate on create.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state clientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	due, err := rotationDue(plan, state, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("key_rotation_interval"), "Invalid key rotation interval", err.Error())
+		return
+	}
+
+	if !due {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("keys_rotated_at"), state.KeysRotatedAt)...)
+		return
+	}
+
+	var config clientResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("keys_rotated_at"), types.StringUnknown())...)
+	// Configured keys belong to the user and are never rotated by Terraform.
+	if config.PublicKey.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public_key"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("private_key"), types.StringUnknown())...)
+	}
+	if config.PresharedKey.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("preshared_key"), types.StringUnknown())...)
+	}
+}
+
+// rotationDue reports whether the keys of an existing client must be regenerated.
+func rotationDue(plan, state clientResourceModel, now time.Time) (bool, error) {
+	// A trigger with no prior value (just added, or after an import, which
+	// cannot know it) is only recorded; rotating then would cut off the device.
+	if !state.RotateKeysTrigger.IsNull() && !plan.RotateKeysTrigger.IsUnknown() && !plan.RotateKeysTrigger.Equal(state.RotateKeysTrigger) {
+		return true, nil
+	}
+
+	if !isSetString(plan.KeyRotationInterval) {
+		return false, nil
+	}
+	interval, err := parseRotationInterval(plan.KeyRotationInterval.ValueString())
+	if err != nil {
+		return false, err
+	}
+
+	// Clients created before rotation was tracked count from their creation.
+	last := state.KeysRotatedAt
+	if last.IsNull() {
+		last = state.CreatedAt
+	}
+	lastRotation, err := client.ParseTimestamp(last.ValueString())
+	if err != nil {
+		// Without a usable timestamp, rotate now to start tracking.
+		return true, nil
+	}
+	return now.Sub(lastRotation) >= interval, nil
+}
+
+// parseRotationInterval parses key_rotation_interval, which must be a positive Go duration.
+func parseRotationInterval(value string) (time.Duration, error) {
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("interval must be positive, got %s", interval)
+	}
+	return interval, nil
+}
+
+// rotateKeys puts freshly generated keys into the update request for every key
+// the plan leaves unknown.
+func rotateKeys(plan clientResourceModel, req *client.UpdateClientRequest) error {
+	if plan.PublicKey.IsUnknown() {
+		privateKey, publicKey, err := client.GenerateKeyPair()
+		if err != nil {
+			return err
+		}
+		req.PrivateKey = &privateKey
+		req.PublicKey = &publicKey
+	}
+	if plan.PresharedKey.IsUnknown() {
+		presharedKey, err := client.GeneratePresharedKey()
+		if err != nil {
+			return err
+		}
+		req.PresharedKey = &presharedKey
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRotationDue(t *testing.T) {
+	now := time.Date(2026, 3, 31, 10, 0, 0, 0, time.UTC)
+	rotatedAt := types.StringValue("2026-03-01T10:00:00Z") // 30 days before now
+
+	tests := []struct {
+		name    string
+		plan    clientResourceModel
+		state   clientResourceModel
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:  "nothing configured",
+			plan:  clientResourceModel{},
+			state: clientResourceModel{KeysRotatedAt: rotatedAt},
+		},
+		{
+			name:  "trigger unchanged",
+			plan:  clientResourceModel{RotateKeysTrigger: types.StringValue("1")},
+			state: clientResourceModel{RotateKeysTrigger: types.StringValue("1"), KeysRotatedAt: rotatedAt},
+		},
+		{
+			name:  "trigger changed",
+			plan:  clientResourceModel{RotateKeysTrigger: types.StringValue("2")},
+			state: clientResourceModel{RotateKeysTrigger: types.StringValue("1"), KeysRotatedAt: rotatedAt},
+			want:  true,
+		},
+		{
+			name:  "trigger added",
+			plan:  clientResourceModel{RotateKeysTrigger: types.StringValue("1")},
+			state: clientResourceModel{RotateKeysTrigger: types.StringNull(), KeysRotatedAt: rotatedAt},
+		},
+		{
+			name:  "trigger set after import",
+			plan:  clientResourceModel{RotateKeysTrigger: types.StringValue("2026-10-incident")},
+			state: clientResourceModel{RotateKeysTrigger: types.StringNull(), KeysRotatedAt: types.StringNull(), CreatedAt: types.StringValue("2026-03-30 10:00:00")},
+		},
+		{
+			name:  "trigger removed",
+			plan:  clientResourceModel{RotateKeysTrigger: types.StringNull()},
+			state: clientResourceModel{RotateKeysTrigger: types.StringValue("1"), KeysRotatedAt: rotatedAt},
+			want:  true,
+		},
+		{
+			name:  "trigger unknown",
+			plan:  clientResourceModel{RotateKeysTrigger: types.StringUnknown()},
+			state: clientResourceModel{RotateKeysTrigger: types.StringValue("1"), KeysRotatedAt: rotatedAt},
+		},
+		{
+			name:  "interval not elapsed",
+			plan:  clientResourceModel{KeyRotationInterval: types.StringValue("721h")},
+			state: clientResourceModel{KeysRotatedAt: rotatedAt},
+		},
+		{
+			name:  "interval elapsed",
+			plan:  clientResourceModel{KeyRotationInterval: types.StringValue("720h")},
+			state: clientResourceModel{KeysRotatedAt: rotatedAt},
+			want:  true,
+		},
+		{
+			name:  "falls back to created_at",
+			plan:  clientResourceModel{KeyRotationInterval: types.StringValue("720h")},
+			state: clientResourceModel{KeysRotatedAt: types.StringNull(), CreatedAt: types.StringValue("2026-03-01 09:00:00")},
+			want:  true,
+		},
+		{
+			name:  "no usable timestamp",
+			plan:  clientResourceModel{KeyRotationInterval: types.StringValue("720h")},
+			state: clientResourceModel{KeysRotatedAt: types.StringNull(), CreatedAt: types.StringValue("")},
+			want:  true,
+		},
+		{
+			name:    "invalid interval",
+			plan:    clientResourceModel{KeyRotationInterval: types.StringValue("banana")},
+			state:   clientResourceModel{KeysRotatedAt: rotatedAt},
+			wantErr: true,
+		},
+		{
+			name:    "non-positive interval",
+			plan:    clientResourceModel{KeyRotationInterval: types.StringValue("0s")},
+			state:   clientResourceModel{KeysRotatedAt: rotatedAt},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rotationDue(tt.plan, tt.state, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("rotationDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringFormat validates that a string attribute parses with check.
+type stringFormat struct {
+	description string
+	check       func(string) error
+}
+
+func (v stringFormat) Description(_ context.Context) string {
+	return "value must be " + v.description
+}
+
+func (v stringFormat) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringFormat) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := v.check(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s must be %s, got %q: %s", req.Path, v.description, req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// isCIDROrIP accepts a CIDR such as 10.8.0.0/24, or a bare address meaning a single host.
+func isCIDROrIP() validator.String {
+	return stringFormat{
+		description: "an IP address or CIDR",
+		check: func(s string) error {
+			if _, err := netip.ParseAddr(s); err == nil {
+				return nil
+			}
+			_, err := netip.ParsePrefix(s)
+			return err
+		},
+	}
+}
+
+// isIP accepts an IPv4 or IPv6 address.
+func isIP() validator.String {
+	return stringFormat{
+		description: "an IP address",
+		check: func(s string) error {
+			_, err := netip.ParseAddr(s)
+			return err
+		},
+	}
+}
+
+// isRFC3339 accepts an RFC 3339 timestamp such as 2026-12-31T00:00:00Z.
+func isRFC3339() validator.String {
+	return stringFormat{
+		description: "an RFC 3339 timestamp",
+		check: func(s string) error {
+			_, err := time.Parse(time.RFC3339, s)
+			return err
+		},
+	}
+}
+
+// isHostPort accepts host:port with a non-empty host and a port from 1 to 65535.
+// IPv6 hosts must be bracketed, e.g. [2001:db8::1]:51820.
+func isHostPort() validator.String {
+	return stringFormat{
+		description: "host:port",
+		check: func(s string) error {
+			host, port, err := net.SplitHostPort(s)
+			if err != nil {
+				return err
+			}
+			if host == "" {
+				return errors.New("missing host")
+			}
+			n, err := strconv.ParseUint(port, 10, 16)
+			if err != nil || n == 0 {
+				return fmt.Errorf("invalid port %q", port)
+			}
+			return nil
+		},
+	}
+}
+
+// isPositiveDuration accepts a Go duration greater than zero, such as 720h.
+func isPositiveDuration() validator.String {
+	return stringFormat{
+		description: "a positive duration",
+		check: func(s string) error {
+			_, err := parseRotationInterval(s)
+			return err
+		},
+	}
+}
+
+// jitterRange checks that j_min does not exceed j_max, which AmneziaWG rejects.
+type jitterRange struct{}
+
+var _ resource.ConfigValidator = jitterRange{}
+
+func (v jitterRange) Description(_ context.Context) string {
+	return "j_min must not be greater than j_max"
+}
+
+func (v jitterRange) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jitterRange) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var jMin, jMax types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("j_min"), &jMin)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("j_max"), &jMax)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !isSetInt64(jMin) || !isSetInt64(jMax) {
+		return
+	}
+	if jMin.ValueInt64() > jMax.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("j_min"),
+			"Invalid jitter range",
+			fmt.Sprintf("j_min (%d) must not be greater than j_max (%d).", jMin.ValueInt64(), jMax.ValueInt64()),
+		)
+	}
+}
@@ -0,0 +1,80 @@
+// Package resourceclient implements the wgeasy_client resource for the Terraform provider.
+package resourceclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func validateString(v validator.String, value string) bool {
+	req := validator.StringRequest{Path: path.Root("test"), ConfigValue: types.StringValue(value)}
+	var resp validator.StringResponse
+	v.ValidateString(context.Background(), req, &resp)
+	return !resp.Diagnostics.HasError()
+}
+
+func TestStringFormatValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		valid     []string
+		invalid   []string
+	}{
+		{
+			name:      "cidr or ip",
+			validator: isCIDROrIP(),
+			valid:     []string{"0.0.0.0/0", "10.8.0.0/24", "10.8.0.2", "::/0", "fd00::/64", "fd00::2"},
+			invalid:   []string{"10.0.0.300/24", "10.8.0.0/33", "not-a-cidr", ""},
+		},
+		{
+			name:      "ip",
+			validator: isIP(),
+			valid:     []string{"1.1.1.1", "2606:4700:4700::1111"},
+			invalid:   []string{"not-an-ip", "1.1.1.1/32", "1.1.1"},
+		},
+		{
+			name:      "rfc3339",
+			validator: isRFC3339(),
+			valid:     []string{"2026-12-31T00:00:00Z", "2026-12-31T00:00:00.000+02:00"},
+			invalid:   []string{"2026-12-31", "31/12/2026", "2026-12-31 00:00:00"},
+		},
+		{
+			name:      "host:port",
+			validator: isHostPort(),
+			valid:     []string{"vpn.example.com:51820", "203.0.113.1:51820", "[2001:db8::1]:51820"},
+			invalid:   []string{"vpn.example.com", ":51820", "vpn.example.com:0", "vpn.example.com:70000", "2001:db8::1:51820"},
+		},
+		{
+			name:      "positive duration",
+			validator: isPositiveDuration(),
+			valid:     []string{"720h", "30m", "1h30m"},
+			invalid:   []string{"banana", "30d", "0s", "-1h", ""},
+		},
+	}
+	for _, tt := range tests {
+		for _, v := range tt.valid {
+			if !validateString(tt.validator, v) {
+				t.Errorf("%s: expected %q to be valid", tt.name, v)
+			}
+		}
+		for _, v := range tt.invalid {
+			if validateString(tt.validator, v) {
+				t.Errorf("%s: expected %q to be invalid", tt.name, v)
+			}
+		}
+	}
+}
+
+func TestStringFormatIgnoresUnknownAndNull(t *testing.T) {
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown()} {
+		var resp validator.StringResponse
+		isHostPort().ValidateString(context.Background(), validator.StringRequest{ConfigValue: value}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("expected %v to be skipped, got %v", value, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+// Package resourceclientstate implements the wgeasy_client_state resource for the Terraform provider.
+package resourceclientstate
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clientStateResourceModel maps the resource schema to a Go struct.
+type clientStateResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	ClientID types.String `tfsdk:"client_id"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+}
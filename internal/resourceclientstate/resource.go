@@ -0,0 +1,144 @@
+// Package resourceclientstate implements the wgeasy_client_state resource for the Terraform provider.
+package resourceclientstate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &clientStateResource{}
+	_ resource.ResourceWithImportState = &clientStateResource{}
+)
+
+type clientStateResource struct {
+	apiClient *client.WGEasyClient
+}
+
+// NewClientStateResource creates a new wgeasy_client_state resource instance.
+func NewClientStateResource() resource.Resource {
+	return &clientStateResource{}
+}
+
+func (r *clientStateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_state"
+}
+
+func (r *clientStateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Enables or disables an existing WireGuard client/peer without managing the rest of its settings. " +
+			"Destroying this resource leaves the client in its current state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the client.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Description: "The ID of the client to enable or disable.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the client is enabled.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *clientStateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	apiClient, ok := req.ProviderData.(*client.WGEasyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.WGEasyClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.apiClient = apiClient
+}
+
+func (r *clientStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan clientStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setEnabled(ctx, plan); err != nil {
+		resp.Diagnostics.AddError("Error setting client state", err.Error())
+		return
+	}
+
+	plan.ID = plan.ClientID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *clientStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state clientStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient, err := r.apiClient.GetClient(ctx, state.ID.ValueString())
+	if err != nil {
+		if _, ok := err.(*client.NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading client", err.Error())
+		return
+	}
+
+	state.ClientID = types.StringValue(apiClient.ID.String())
+	state.Enabled = types.BoolValue(apiClient.Enabled)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *clientStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan clientStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setEnabled(ctx, plan); err != nil {
+		resp.Diagnostics.AddError("Error updating client state", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from state: the client keeps its current
+// enabled/disabled state.
+func (r *clientStateResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *clientStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *clientStateResource) setEnabled(ctx context.Context, plan clientStateResourceModel) error {
+	if plan.Enabled.ValueBool() {
+		return r.apiClient.EnableClient(ctx, plan.ClientID.ValueString())
+	}
+	return r.apiClient.DisableClient(ctx, plan.ClientID.ValueString())
+}
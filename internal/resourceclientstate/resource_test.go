@@ -0,0 +1,165 @@
+// Package resourceclientstate implements the wgeasy_client_state resource for the Terraform provider.
+package resourceclientstate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fakeAPI is an in-memory wg-easy client store that records enable/disable
+// calls. It only serves the routes wgeasy_client_state uses.
+type fakeAPI struct {
+	mu      sync.Mutex
+	clients map[string]*client.Client
+	writes  []string
+}
+
+func setupTestResource(t *testing.T, api *fakeAPI) *clientStateResource {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/session", func(w http.ResponseWriter, _ *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "test"})
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET /api/client/{id}", func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		c, ok := api.clients[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(c)
+	})
+	mux.HandleFunc("POST /api/client/{id}/{action}", func(w http.ResponseWriter, r *http.Request) {
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		c, ok := api.clients[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		action := r.PathValue("action")
+		c.Enabled = action == "enable"
+		api.writes = append(api.writes, action)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewWGEasyClient(server.URL, "admin", "secret")
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+	return &clientStateResource{apiClient: apiClient}
+}
+
+func newState(t *testing.T, r *clientStateResource, model clientStateResourceModel) tfsdk.State {
+	t.Helper()
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	state := tfsdk.State{Schema: resp.Schema}
+	if diags := state.Set(context.Background(), &model); diags.HasError() {
+		t.Fatalf("building state: %v", diags)
+	}
+	return state
+}
+
+func getModel(t *testing.T, state tfsdk.State) clientStateResourceModel {
+	t.Helper()
+	var model clientStateResourceModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("reading state: %v", diags)
+	}
+	return model
+}
+
+func TestEnableDisableEnable(t *testing.T) {
+	ctx := context.Background()
+	api := &fakeAPI{clients: map[string]*client.Client{"1": {ID: "1", Name: "laptop", Enabled: false}}}
+	r := setupTestResource(t, api)
+
+	planned := newState(t, r, clientStateResourceModel{
+		ID:       types.StringUnknown(),
+		ClientID: types.StringValue("1"),
+		Enabled:  types.BoolValue(true),
+	})
+	createResp := resource.CreateResponse{State: planned}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(planned)}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("create: %v", createResp.Diagnostics)
+	}
+	state := createResp.State
+	if id := getModel(t, state).ID.ValueString(); id != "1" {
+		t.Errorf("expected id 1, got %q", id)
+	}
+
+	for _, enabled := range []bool{false, true} {
+		model := getModel(t, state)
+		model.Enabled = types.BoolValue(enabled)
+		plan := newState(t, r, model)
+		updateResp := resource.UpdateResponse{State: plan}
+		r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(plan), State: state}, &updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("update: %v", updateResp.Diagnostics)
+		}
+		state = updateResp.State
+		if api.clients["1"].Enabled != enabled {
+			t.Errorf("expected enabled=%v on the server, got %v", enabled, api.clients["1"].Enabled)
+		}
+	}
+
+	if want := []string{"enable", "disable", "enable"}; !slices.Equal(api.writes, want) {
+		t.Errorf("expected writes %v, got %v", want, api.writes)
+	}
+
+	// Read picks up a change made in the admin UI.
+	api.clients["1"].Enabled = false
+	readResp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", readResp.Diagnostics)
+	}
+	if getModel(t, readResp.State).Enabled.ValueBool() {
+		t.Error("expected Read to report the client as disabled")
+	}
+}
+
+func TestReadRemovesClientDeletedOutOfBand(t *testing.T) {
+	ctx := context.Background()
+	api := &fakeAPI{clients: map[string]*client.Client{"1": {ID: "1", Name: "laptop", Enabled: true}}}
+	r := setupTestResource(t, api)
+
+	state := newState(t, r, clientStateResourceModel{
+		ID:       types.StringValue("1"),
+		ClientID: types.StringValue("1"),
+		Enabled:  types.BoolValue(true),
+	})
+	// The first read confirms the per-client route, so its later 404 is trusted.
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", resp.Diagnostics)
+	}
+
+	delete(api.clients, "1")
+	resp = resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("read: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected the deleted client to be removed from state")
+	}
+}
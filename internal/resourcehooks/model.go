@@ -0,0 +1,15 @@
+// Package resourcehooks implements the wgeasy_hooks resource for the Terraform provider.
+package resourcehooks
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// hooksResourceModel maps the resource schema to a Go struct.
+type hooksResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	PreUp    types.String `tfsdk:"pre_up"`
+	PostUp   types.String `tfsdk:"post_up"`
+	PreDown  types.String `tfsdk:"pre_down"`
+	PostDown types.String `tfsdk:"post_down"`
+}
@@ -0,0 +1,194 @@
+// Package resourcehooks implements the wgeasy_hooks resource for the Terraform provider.
+package resourcehooks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// hooksID is the fixed ID of the singleton hooks resource.
+const hooksID = "hooks"
+
+var (
+	_ resource.Resource                = &hooksResource{}
+	_ resource.ResourceWithImportState = &hooksResource{}
+)
+
+type hooksResource struct {
+	apiClient *client.WGEasyClient
+}
+
+// NewHooksResource creates a new wgeasy_hooks resource instance.
+func NewHooksResource() resource.Resource {
+	return &hooksResource{}
+}
+
+func (r *hooksResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hooks"
+}
+
+func (r *hooksResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the PreUp/PostUp/PreDown/PostDown scripts of the wg-easy interface. " +
+			"Omitted hooks keep their current server value; set a hook to an empty string to clear it. " +
+			"Destroying this resource leaves the hooks on the server unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Fixed identifier of the hooks resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pre_up": schema.StringAttribute{
+				Description: "Command to run before bringing up the interface.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"post_up": schema.StringAttribute{
+				Description: "Command to run after bringing up the interface.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pre_down": schema.StringAttribute{
+				Description: "Command to run before bringing down the interface.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"post_down": schema.StringAttribute{
+				Description: "Command to run after bringing down the interface.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *hooksResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	apiClient, ok := req.ProviderData.(*client.WGEasyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.WGEasyClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.apiClient = apiClient
+}
+
+func (r *hooksResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan hooksResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hooks, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error setting hooks", err.Error())
+		return
+	}
+
+	mapHooksToState(hooks, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *hooksResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state hooksResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Always take the server values so hooks edited in the UI show up as drift.
+	hooks, err := r.apiClient.GetHooks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading hooks", err.Error())
+		return
+	}
+
+	mapHooksToState(hooks, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *hooksResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan hooksResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hooks, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating hooks", err.Error())
+		return
+	}
+
+	mapHooksToState(hooks, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the hooks from state: clearing them would tear down the
+// NAT rules that live peers depend on.
+func (r *hooksResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *hooksResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply overlays the configured hooks on the current ones, so hooks the
+// configuration does not mention are left as they are on the server.
+func (r *hooksResource) apply(ctx context.Context, plan hooksResourceModel) (*client.Hooks, error) {
+	current, err := r.apiClient.GetHooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.apiClient.UpdateHooks(ctx, buildHooks(plan, current))
+}
+
+func buildHooks(plan hooksResourceModel, current *client.Hooks) client.Hooks {
+	hooks := *current
+	applyStringField(plan.PreUp, &hooks.PreUp)
+	applyStringField(plan.PostUp, &hooks.PostUp)
+	applyStringField(plan.PreDown, &hooks.PreDown)
+	applyStringField(plan.PostDown, &hooks.PostDown)
+	return hooks
+}
+
+func applyStringField(val types.String, target *string) {
+	if !val.IsNull() && !val.IsUnknown() {
+		*target = val.ValueString()
+	}
+}
+
+func mapHooksToState(hooks *client.Hooks, state *hooksResourceModel) {
+	state.ID = types.StringValue(hooksID)
+	state.PreUp = types.StringValue(hooks.PreUp)
+	state.PostUp = types.StringValue(hooks.PostUp)
+	state.PreDown = types.StringValue(hooks.PreDown)
+	state.PostDown = types.StringValue(hooks.PostDown)
+}
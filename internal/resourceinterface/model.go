@@ -0,0 +1,20 @@
+// Package resourceinterface implements the wgeasy_interface resource for the Terraform provider.
+package resourceinterface
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// interfaceResourceModel maps the resource schema to a Go struct.
+type interfaceResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Device    types.String `tfsdk:"device"`
+	Port      types.Int64  `tfsdk:"port"`
+	MTU       types.Int64  `tfsdk:"mtu"`
+	IPv4CIDR  types.String `tfsdk:"ipv4_cidr"`
+	IPv6CIDR  types.String `tfsdk:"ipv6_cidr"`
+	JC        types.Int64  `tfsdk:"jc"`
+	JMin      types.Int64  `tfsdk:"j_min"`
+	JMax      types.Int64  `tfsdk:"j_max"`
+	PublicKey types.String `tfsdk:"public_key"`
+}
@@ -0,0 +1,275 @@
+// Package resourceinterface implements the wgeasy_interface resource for the Terraform provider.
+package resourceinterface
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &interfaceResource{}
+	_ resource.ResourceWithImportState = &interfaceResource{}
+)
+
+type interfaceResource struct {
+	apiClient *client.WGEasyClient
+}
+
+// NewInterfaceResource creates a new wgeasy_interface resource instance.
+func NewInterfaceResource() resource.Resource {
+	return &interfaceResource{}
+}
+
+func (r *interfaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interface"
+}
+
+func (r *interfaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the server-side WireGuard interface of a wg-easy instance. " +
+			"The interface always exists, so creating this resource adopts it and destroying it only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The name of the interface (e.g. wg0).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device": schema.StringAttribute{
+				Description: "The network device WireGuard traffic is forwarded through (e.g. eth0).",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description: "The UDP port the interface listens on.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"mtu": schema.Int64Attribute{
+				Description: "MTU value for the interface.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipv4_cidr": schema.StringAttribute{
+				Description: "The IPv4 range client addresses are allocated from. Changing it re-addresses all clients.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipv6_cidr": schema.StringAttribute{
+				Description: "The IPv6 range client addresses are allocated from. Changing it re-addresses all clients.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"jc": schema.Int64Attribute{
+				Description: "Default jitter coefficient (jC) for AmneziaWG.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"j_min": schema.Int64Attribute{
+				Description: "Default minimum jitter value (jMin) for AmneziaWG.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"j_max": schema.Int64Attribute{
+				Description: "Default maximum jitter value (jMax) for AmneziaWG.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The public key of the interface.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *interfaceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	apiClient, ok := req.ProviderData.(*client.WGEasyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.WGEasyClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.apiClient = apiClient
+}
+
+func (r *interfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan interfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error configuring interface", err.Error())
+		return
+	}
+
+	mapInterfaceToState(iface, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *interfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state interfaceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface, err := r.apiClient.GetInterface(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading interface", err.Error())
+		return
+	}
+
+	mapInterfaceToState(iface, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *interfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan interfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	iface, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating interface", err.Error())
+		return
+	}
+
+	mapInterfaceToState(iface, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the interface from state: wg-easy cannot run without it.
+func (r *interfaceResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *interfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply pushes the planned settings to the server and returns the resulting interface.
+// CIDRs go through their own endpoint and are only sent when they actually change.
+func (r *interfaceResource) apply(ctx context.Context, plan interfaceResourceModel) (*client.Interface, error) {
+	current, err := r.apiClient.GetInterface(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updateReq := buildUpdateRequest(plan, current)
+	iface, err := r.apiClient.UpdateInterface(ctx, updateReq)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrReq := client.UpdateInterfaceCIDRRequest{
+		IPv4CIDR: iface.IPv4CIDR,
+		IPv6CIDR: iface.IPv6CIDR,
+	}
+	applyStringField(plan.IPv4CIDR, &cidrReq.IPv4CIDR)
+	applyStringField(plan.IPv6CIDR, &cidrReq.IPv6CIDR)
+	if cidrReq.IPv4CIDR != iface.IPv4CIDR || cidrReq.IPv6CIDR != iface.IPv6CIDR {
+		return r.apiClient.UpdateInterfaceCIDR(ctx, cidrReq)
+	}
+
+	return iface, nil
+}
+
+// buildUpdateRequest builds an update request starting from the current API state,
+// then overlays any values from the plan.
+func buildUpdateRequest(plan interfaceResourceModel, current *client.Interface) client.UpdateInterfaceRequest {
+	req := client.UpdateInterfaceRequest{
+		Device:  current.Device,
+		Port:    current.Port,
+		MTU:     current.MTU,
+		JC:      current.JC,
+		JMin:    current.JMin,
+		JMax:    current.JMax,
+		S1:      current.S1,
+		S2:      current.S2,
+		H1:      current.H1,
+		H2:      current.H2,
+		H3:      current.H3,
+		H4:      current.H4,
+		Enabled: current.Enabled,
+	}
+
+	applyStringField(plan.Device, &req.Device)
+	applyInt64Field(plan.Port, &req.Port)
+	applyInt64Field(plan.MTU, &req.MTU)
+	applyInt64Field(plan.JC, &req.JC)
+	applyInt64Field(plan.JMin, &req.JMin)
+	applyInt64Field(plan.JMax, &req.JMax)
+	return req
+}
+
+func applyInt64Field(val types.Int64, target *int64) {
+	if !val.IsNull() && !val.IsUnknown() {
+		*target = val.ValueInt64()
+	}
+}
+
+func applyStringField(val types.String, target *string) {
+	if !val.IsNull() && !val.IsUnknown() {
+		*target = val.ValueString()
+	}
+}
+
+func mapInterfaceToState(iface *client.Interface, state *interfaceResourceModel) {
+	state.ID = types.StringValue(iface.Name)
+	state.Device = types.StringValue(iface.Device)
+	state.Port = types.Int64Value(iface.Port)
+	state.MTU = types.Int64Value(iface.MTU)
+	state.IPv4CIDR = types.StringValue(iface.IPv4CIDR)
+	state.IPv6CIDR = types.StringValue(iface.IPv6CIDR)
+	state.JC = types.Int64Value(iface.JC)
+	state.JMin = types.Int64Value(iface.JMin)
+	state.JMax = types.Int64Value(iface.JMax)
+	state.PublicKey = types.StringValue(iface.PublicKey)
+}
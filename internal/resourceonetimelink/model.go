@@ -0,0 +1,14 @@
+// Package resourceonetimelink implements the wgeasy_client_one_time_link resource for the Terraform provider.
+package resourceonetimelink
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// oneTimeLinkResourceModel maps the resource schema to a Go struct.
+type oneTimeLinkResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ClientID  types.String `tfsdk:"client_id"`
+	URL       types.String `tfsdk:"url"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
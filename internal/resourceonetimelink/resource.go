@@ -0,0 +1,156 @@
+// Package resourceonetimelink implements the wgeasy_client_one_time_link resource for the Terraform provider.
+package resourceonetimelink
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &oneTimeLinkResource{}
+
+type oneTimeLinkResource struct {
+	apiClient *client.WGEasyClient
+}
+
+// NewOneTimeLinkResource creates a new wgeasy_client_one_time_link resource instance.
+func NewOneTimeLinkResource() resource.Resource {
+	return &oneTimeLinkResource{}
+}
+
+func (r *oneTimeLinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_one_time_link"
+}
+
+func (r *oneTimeLinkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a one-time download link for the configuration of a WireGuard client/peer. " +
+			"Once the link has been used or has expired, the next plan generates a new one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the client the link belongs to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Description: "The ID of the client to generate the link for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The one-time download URL of the client configuration.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "The expiration timestamp of the link.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *oneTimeLinkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	apiClient, ok := req.ProviderData.(*client.WGEasyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.WGEasyClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.apiClient = apiClient
+}
+
+func (r *oneTimeLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan oneTimeLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, err := r.apiClient.GenerateOneTimeLink(ctx, plan.ClientID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating one-time link", err.Error())
+		return
+	}
+
+	plan.ID = plan.ClientID
+	plan.URL = types.StringValue(r.apiClient.OneTimeLinkURL(link.OneTimeLink))
+	plan.ExpiresAt = types.StringValue(link.ExpiresAt)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *oneTimeLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state oneTimeLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiClient, err := r.apiClient.GetClient(ctx, state.ClientID.ValueString())
+	if err != nil {
+		if _, ok := err.(*client.NotFoundError); ok {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading client", err.Error())
+		return
+	}
+
+	// A consumed, replaced or expired link is gone: drop it so the next apply generates a new one.
+	link := apiClient.OneTimeLink
+	if link == nil || r.apiClient.OneTimeLinkURL(link.OneTimeLink) != state.URL.ValueString() || isExpired(link.ExpiresAt) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ExpiresAt = types.StringValue(link.ExpiresAt)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called with real changes: client_id forces replacement and
+// everything else is computed.
+func (r *oneTimeLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan oneTimeLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the link from state: wg-easy has no endpoint to revoke
+// a one-time link, it disappears once used or expired.
+func (r *oneTimeLinkResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// isExpired reports whether an API expiration timestamp lies in the past.
+// Unparseable timestamps are treated as not expired.
+func isExpired(expiresAt string) bool {
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false
+	}
+	return time.Now().After(t)
+}
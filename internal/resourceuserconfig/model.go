@@ -0,0 +1,17 @@
+// Package resourceuserconfig implements the wgeasy_user_config resource for the Terraform provider.
+package resourceuserconfig
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// userConfigResourceModel maps the resource schema to a Go struct.
+type userConfigResourceModel struct {
+	ID                         types.String `tfsdk:"id"`
+	Host                       types.String `tfsdk:"host"`
+	Port                       types.Int64  `tfsdk:"port"`
+	DefaultMTU                 types.Int64  `tfsdk:"default_mtu"`
+	DefaultPersistentKeepalive types.Int64  `tfsdk:"default_persistent_keepalive"`
+	DefaultDNS                 types.List   `tfsdk:"default_dns"`
+	DefaultAllowedIPs          types.List   `tfsdk:"default_allowed_ips"`
+}
@@ -0,0 +1,257 @@
+// Package resourceuserconfig implements the wgeasy_user_config resource for the Terraform provider.
+package resourceuserconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Nastaliss/terraform-provider-wgeasy/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &userConfigResource{}
+	_ resource.ResourceWithImportState = &userConfigResource{}
+)
+
+type userConfigResource struct {
+	apiClient *client.WGEasyClient
+}
+
+// NewUserConfigResource creates a new wgeasy_user_config resource instance.
+func NewUserConfigResource() resource.Resource {
+	return &userConfigResource{}
+}
+
+func (r *userConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_config"
+}
+
+func (r *userConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the default settings wg-easy applies to client configurations. " +
+			"These are the server defaults used by wgeasy_client attributes left empty. " +
+			"The configuration always exists, so creating this resource adopts it and destroying it only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The name of the interface the configuration belongs to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Description: "The public host clients connect to.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Description: "The public port clients connect to.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_mtu": schema.Int64Attribute{
+				Description: "Default MTU value for clients.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_persistent_keepalive": schema.Int64Attribute{
+				Description: "Default persistent keepalive interval in seconds for clients.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_dns": schema.ListAttribute{
+				Description: "Default list of DNS servers for clients.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_allowed_ips": schema.ListAttribute{
+				Description: "Default list of allowed IPs for clients.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *userConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	apiClient, ok := req.ProviderData.(*client.WGEasyClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.WGEasyClient, got: %T", req.ProviderData),
+		)
+		return
+	}
+	r.apiClient = apiClient
+}
+
+func (r *userConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userConfig, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error configuring user config", err.Error())
+		return
+	}
+
+	mapUserConfigToState(ctx, userConfig, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *userConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userConfig, err := r.apiClient.GetUserConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading user config", err.Error())
+		return
+	}
+
+	mapUserConfigToState(ctx, userConfig, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *userConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userConfig, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating user config", err.Error())
+		return
+	}
+
+	mapUserConfigToState(ctx, userConfig, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the configuration from state: wg-easy cannot run without it.
+func (r *userConfigResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *userConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply pushes the planned settings to the server and returns the resulting configuration.
+func (r *userConfigResource) apply(ctx context.Context, plan userConfigResourceModel) (*client.UserConfig, error) {
+	current, err := r.apiClient.GetUserConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updateReq := buildUpdateRequest(ctx, plan, current)
+	return r.apiClient.UpdateUserConfig(ctx, updateReq)
+}
+
+// buildUpdateRequest builds an update request starting from the current API state,
+// then overlays any values from the plan.
+func buildUpdateRequest(ctx context.Context, plan userConfigResourceModel, current *client.UserConfig) client.UpdateUserConfigRequest {
+	req := client.UpdateUserConfigRequest{
+		Host:                       current.Host,
+		Port:                       current.Port,
+		DefaultMTU:                 current.DefaultMTU,
+		DefaultPersistentKeepalive: current.DefaultPersistentKeepalive,
+		DefaultDNS:                 current.DefaultDNS,
+		DefaultAllowedIPs:          current.DefaultAllowedIPs,
+	}
+
+	if !plan.Host.IsNull() && !plan.Host.IsUnknown() {
+		req.Host = plan.Host.ValueString()
+	}
+	applyInt64Field(plan.Port, &req.Port)
+	applyInt64Field(plan.DefaultMTU, &req.DefaultMTU)
+	applyInt64Field(plan.DefaultPersistentKeepalive, &req.DefaultPersistentKeepalive)
+	applyListField(ctx, plan.DefaultDNS, &req.DefaultDNS)
+	applyListField(ctx, plan.DefaultAllowedIPs, &req.DefaultAllowedIPs)
+	return req
+}
+
+func applyInt64Field(val types.Int64, target *int64) {
+	if !val.IsNull() && !val.IsUnknown() {
+		*target = val.ValueInt64()
+	}
+}
+
+func applyListField(ctx context.Context, list types.List, target *[]string) {
+	if list.IsNull() || list.IsUnknown() {
+		return
+	}
+	var values []string
+	list.ElementsAs(ctx, &values, false)
+	*target = values
+}
+
+func mapUserConfigToState(ctx context.Context, userConfig *client.UserConfig, state *userConfigResourceModel, diags *diag.Diagnostics) {
+	state.ID = types.StringValue(userConfig.ID)
+	state.Host = types.StringValue(userConfig.Host)
+	state.Port = types.Int64Value(userConfig.Port)
+	state.DefaultMTU = types.Int64Value(userConfig.DefaultMTU)
+	state.DefaultPersistentKeepalive = types.Int64Value(userConfig.DefaultPersistentKeepalive)
+	state.DefaultDNS = sliceToList(ctx, userConfig.DefaultDNS, diags)
+	state.DefaultAllowedIPs = sliceToList(ctx, userConfig.DefaultAllowedIPs, diags)
+}
+
+func sliceToList(ctx context.Context, slice []string, diags *diag.Diagnostics) types.List {
+	if slice == nil {
+		slice = []string{}
+	}
+	list, d := types.ListValueFrom(ctx, types.StringType, slice)
+	diags.Append(d...)
+	return list
+}